@@ -0,0 +1,52 @@
+// Package example is the example input of funcvalid-gen, and its generated validators are the golden
+// files of its tests.
+package example
+
+import (
+	"errors"
+	"regexp"
+	"time"
+)
+
+//go:generate go run github.com/krizmak/funcvalid/cmd/funcvalid-gen -spec address.json user.go
+
+var zipRegexp = regexp.MustCompile(`^\d{4}$`)
+
+func notInFuture(t time.Time) error {
+	if t.After(time.Now()) {
+		return errors.New("error: notInFuture")
+	}
+	return nil
+}
+
+type User struct {
+	//funcvalid: fv.LenBw[string](1, 30), fv.NoWhitespace
+	Name string `json:"name"`
+	//funcvalid:optional fv.Email
+	Email string `json:"email,omitempty"`
+	Age   int    `json:"age"` //funcvalid: fv.Gt(17), fv.Lt(130)
+	//funcvalid: fv.Each(fv.OneOf[Role]("admin", "editor"))
+	Roles []Role `json:"roles"`
+	//funcvalid:optional notInFuture
+	Joined time.Time `json:"joined"`
+	// The unannotated fields are not validated.
+	Nickname  string
+	Address   Address   `json:"address"`
+	Addresses []Address `json:"addresses"`
+	Billing   *Address  `json:"billing"`
+}
+
+type Role string
+
+type Address struct {
+	Country string `json:"country"`
+	Zip     string `json:"zip"`
+	City    string
+}
+
+// Category is a recursive type.
+type Category struct {
+	//funcvalid: fv.LenBw[string](1, 30)
+	Name     string     `json:"name"`
+	Children []Category `json:"children"`
+}
@@ -0,0 +1,50 @@
+// Code generated by funcvalid-gen from user.go. DO NOT EDIT.
+
+package example
+
+import (
+	"time"
+
+	fv "github.com/krizmak/funcvalid"
+)
+
+var validateUser = fv.Struct(
+	fv.Field("name", func(u User) string { return u.Name }, fv.And(fv.LenBw[string](1, 30), fv.NoWhitespace)),
+	fv.OptionalField("email", func(u User) string { return u.Email }, fv.Email),
+	fv.Field("age", func(u User) int { return u.Age }, fv.And(fv.Gt(17), fv.Lt(130))),
+	fv.Field("roles", func(u User) []Role { return u.Roles }, fv.Each(fv.OneOf[Role]("admin", "editor"))),
+	fv.OptionalField("joined", func(u User) time.Time { return u.Joined }, notInFuture),
+	fv.Field("address", func(u User) Address { return u.Address }, validateAddress),
+	fv.Field("addresses", func(u User) []Address { return u.Addresses }, fv.Each(validateAddress)),
+	fv.Field("billing", func(u User) *Address { return u.Billing }, fv.Deref(validateAddress)),
+)
+
+// Validate validates the fields of the User.
+func (u User) Validate() error {
+	return validateUser(u)
+}
+
+var validateAddress = fv.Struct(
+	fv.Field("country", func(a Address) string { return a.Country }, fv.Iso3166Alpha2),
+	fv.Field("zip", func(a Address) string { return a.Zip }, fv.RegexpRE(zipRegexp)),
+	fv.OptionalField("City", func(a Address) string { return a.City }, fv.And(fv.LenBw[string](1, 50), fv.Trimmed)),
+)
+
+// Validate validates the fields of the Address.
+func (a Address) Validate() error {
+	return validateAddress(a)
+}
+
+var validateCategory fv.Validator[Category]
+
+func init() {
+	validateCategory = fv.Struct(
+		fv.Field("name", func(c Category) string { return c.Name }, fv.LenBw[string](1, 30)),
+		fv.Field("children", func(c Category) []Category { return c.Children }, fv.Each(func(v Category) error { return validateCategory(v) })),
+	)
+}
+
+// Validate validates the fields of the Category.
+func (c Category) Validate() error {
+	return validateCategory(c)
+}
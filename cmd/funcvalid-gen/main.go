@@ -0,0 +1,402 @@
+// Command funcvalid-gen generates the validators of the annotated structs of a Go file, so they are
+// type-checked by the compiler instead of being interpreted by reflection at runtime. It's meant to be
+// run by go generate:
+//
+//	//go:generate go run github.com/krizmak/funcvalid/cmd/funcvalid-gen
+//
+//	type User struct {
+//		//funcvalid: fv.LenBw[string](1, 30), fv.NoWhitespace
+//		Name string `json:"name"`
+//		//funcvalid:optional fv.Email
+//		Email string `json:"email"`
+//	}
+//
+// The annotations of the fields are the comma-separated validators of the fields (And-ed together), the
+// optional keyword skips the zero values (see OptionalField). The annotations can also be given in a
+// sidecar JSON spec (see the -spec flag) keyed by the type and the field names, e.g.
+// {"User": {"Name": "fv.LenBw[string](1, 30)"}}.
+//
+// For every annotated struct it generates a validator variable (e.g. validateUser) composed by Struct and
+// Field, and a Validate() error method. The fields are named by their json tags (or by their names), and
+// the fields of annotated struct types (or pointers or slices of them) are validated by the validators of
+// their types, so the errors carry the field paths as nested FieldErrors. The nil pointers are valid (see
+// Deref), and the validators of the recursive types are assigned in an init function.
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	funcvalidPath    = "github.com/krizmak/funcvalid"
+	annotationPrefix = "//funcvalid:"
+)
+
+func main() {
+	specFile := flag.String("spec", "", "JSON `file` with the annotations of the fields by type and field names")
+	output := flag.String("output", "", "output `file` (default <input>_funcvalid.go)")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "usage: funcvalid-gen [flags] [file.go]\n")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	input := flag.Arg(0)
+	if input == "" {
+		input = os.Getenv("GOFILE")
+	}
+	if input == "" || flag.NArg() > 1 {
+		flag.Usage()
+		os.Exit(2)
+	}
+	if err := run(input, *specFile, *output); err != nil {
+		fmt.Fprintln(os.Stderr, "funcvalid-gen:", err)
+		os.Exit(1)
+	}
+}
+
+func run(input string, specFile string, output string) error {
+	src, err := os.ReadFile(input)
+	if err != nil {
+		return err
+	}
+	var spec map[string]map[string]string
+	if specFile != "" {
+		data, err := os.ReadFile(specFile)
+		if err != nil {
+			return err
+		}
+		if err := json.Unmarshal(data, &spec); err != nil {
+			return fmt.Errorf("%s: %w", specFile, err)
+		}
+	}
+	generated, err := generate(filepath.Base(input), src, spec)
+	if err != nil {
+		return err
+	}
+	if output == "" {
+		output = strings.TrimSuffix(input, ".go") + "_funcvalid.go"
+	}
+	return os.WriteFile(output, generated, 0o644)
+}
+
+type structType struct {
+	name   string
+	fields []field
+}
+
+type field struct {
+	name       string   // the name of the field in the errors
+	goName     string   // the name of the field in Go
+	typ        ast.Expr // the type of the field
+	validators []string // the validator expressions
+	optional   bool
+	ref        string // the generated type that the field refers to, if any
+	refWrap    string // the format of the validator of the field by the validator of the generated type
+}
+
+// Generates the source of the validators of the annotated structs of the Go source.
+func generate(filename string, src []byte, spec map[string]map[string]string) ([]byte, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, filename, src, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+	specs := map[string]map[string]string{} // the unused annotations of the spec
+	for name, fields := range spec {
+		specs[name] = map[string]string{}
+		for fieldName, annotation := range fields {
+			specs[name][fieldName] = annotation
+		}
+	}
+	imports := map[string]string{} // the paths of the imports by their names
+	for _, imp := range file.Imports {
+		path, _ := strconv.Unquote(imp.Path.Value)
+		name := path[strings.LastIndex(path, "/")+1:]
+		if imp.Name != nil {
+			name = imp.Name.Name
+		}
+		imports[name] = path
+	}
+	fv := "fv"
+	for name, path := range imports {
+		if path == funcvalidPath {
+			fv = name
+		}
+	}
+
+	// the annotated structs, and the imports that their annotations and field types use
+	var structs []*structType
+	used := map[string]bool{fv: true}
+	for _, decl := range file.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.TYPE {
+			continue
+		}
+		for _, s := range gen.Specs {
+			ts := s.(*ast.TypeSpec)
+			st, ok := ts.Type.(*ast.StructType)
+			if !ok || ts.TypeParams != nil {
+				continue
+			}
+			typeSpec := specs[ts.Name.Name]
+			t := &structType{name: ts.Name.Name}
+			annotated := typeSpec != nil
+			for _, f := range st.Fields.List {
+				for _, name := range f.Names {
+					annotation, ok := typeSpec[name.Name]
+					delete(typeSpec, name.Name)
+					if !ok {
+						annotation, ok = fieldAnnotation(f)
+					}
+					fld := field{name: fieldName(f, name.Name), goName: name.Name, typ: f.Type}
+					if ok {
+						annotated = true
+						fld.optional, fld.validators, err = parseAnnotation(annotation, used)
+						if err != nil {
+							return nil, fmt.Errorf("%s: %s.%s: %w", fset.Position(f.Pos()), t.name, name.Name, err)
+						}
+						collectPackages(f.Type, used)
+					}
+					t.fields = append(t.fields, fld)
+				}
+			}
+			for name := range typeSpec {
+				return nil, fmt.Errorf("spec: %s.%s: no such field", t.name, name)
+			}
+			delete(specs, ts.Name.Name)
+			if annotated {
+				structs = append(structs, t)
+			}
+		}
+	}
+	for name := range specs {
+		return nil, fmt.Errorf("spec: %s: no such struct", name)
+	}
+
+	// the fields of the annotated struct types (their values, pointers or slices) are validated by their
+	// validators
+	generated := map[string]bool{}
+	for _, t := range structs {
+		generated[t.name] = true
+	}
+	refs := map[string][]string{} // the generated types referenced by the fields of the types
+	for _, t := range structs {
+		for i, f := range t.fields {
+			elem, wrap := f.typ, "%s"
+			if array, ok := elem.(*ast.ArrayType); ok && array.Len == nil {
+				elem, wrap = array.Elt, fv+".Each(%s)"
+			}
+			if star, ok := elem.(*ast.StarExpr); ok {
+				elem, wrap = star.X, fmt.Sprintf(wrap, fv+".Deref(%s)")
+			}
+			if ident, ok := elem.(*ast.Ident); ok && generated[ident.Name] {
+				t.fields[i].ref, t.fields[i].refWrap = ident.Name, wrap
+				refs[t.name] = append(refs[t.name], ident.Name)
+			}
+		}
+	}
+	// the validators of the recursive types are assigned in init, and they are referenced through
+	// function literals, since a variable can't refer to itself in its initialization
+	recursive := map[string]bool{}
+	for _, t := range structs {
+		recursive[t.name] = reaches(refs, t.name, t.name, map[string]bool{})
+	}
+	for _, t := range structs {
+		for i, f := range t.fields {
+			if f.ref == "" {
+				continue
+			}
+			ref := "validate" + f.ref
+			if recursive[f.ref] {
+				ref = fmt.Sprintf("func(v %s) error { return validate%s(v) }", f.ref, f.ref)
+			}
+			t.fields[i].validators = append(f.validators, fmt.Sprintf(f.refWrap, ref))
+		}
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by funcvalid-gen from %s. DO NOT EDIT.\n\n", filename)
+	fmt.Fprintf(&buf, "package %s\n\n", file.Name.Name)
+	if len(structs) > 0 {
+		var names []string
+		for name := range used {
+			if _, ok := imports[name]; ok || name == fv {
+				names = append(names, name)
+			}
+		}
+		// the standard library packages go first, in a separate group
+		sort.Slice(names, func(i, j int) bool {
+			pi, pj := importPath(imports, names[i]), importPath(imports, names[j])
+			if isStd(pi) != isStd(pj) {
+				return isStd(pi)
+			}
+			return pi < pj
+		})
+		buf.WriteString("import (\n")
+		for i, name := range names {
+			path := importPath(imports, name)
+			if i > 0 && isStd(importPath(imports, names[i-1])) && !isStd(path) {
+				buf.WriteString("\n")
+			}
+			if path[strings.LastIndex(path, "/")+1:] == name {
+				fmt.Fprintf(&buf, "\t%q\n", path)
+			} else {
+				fmt.Fprintf(&buf, "\t%s %q\n", name, path)
+			}
+		}
+		buf.WriteString(")\n")
+	}
+	for _, t := range structs {
+		recv := strings.ToLower(t.name[:1])
+		if recursive[t.name] {
+			fmt.Fprintf(&buf, "\nvar validate%s %s.Validator[%s]\n", t.name, fv, t.name)
+			fmt.Fprintf(&buf, "\nfunc init() {\n\tvalidate%s = %s.Struct(\n", t.name, fv)
+		} else {
+			fmt.Fprintf(&buf, "\nvar validate%s = %s.Struct(\n", t.name, fv)
+		}
+		for _, f := range t.fields {
+			if len(f.validators) == 0 {
+				continue
+			}
+			factory := "Field"
+			if f.optional {
+				factory = "OptionalField"
+			}
+			validator := f.validators[0]
+			if len(f.validators) > 1 {
+				validator = fv + ".And(" + strings.Join(f.validators, ", ") + ")"
+			}
+			fmt.Fprintf(&buf, "\t%s.%s(%q, func(%s %s) %s { return %s.%s }, %s),\n",
+				fv, factory, f.name, recv, t.name, exprString(f.typ), recv, f.goName, validator)
+		}
+		buf.WriteString(")\n")
+		if recursive[t.name] {
+			buf.WriteString("}\n")
+		}
+		fmt.Fprintf(&buf, "\n// Validate validates the fields of the %s.\n", t.name)
+		fmt.Fprintf(&buf, "func (%s %s) Validate() error {\n\treturn validate%s(%s)\n}\n", recv, t.name, t.name, recv)
+	}
+	out, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("invalid generated code: %w\n%s", err, buf.Bytes())
+	}
+	return out, nil
+}
+
+// Reports if the type reaches the target type through the references of the types.
+func reaches(refs map[string][]string, from string, target string, visited map[string]bool) bool {
+	for _, ref := range refs[from] {
+		if ref == target {
+			return true
+		}
+		if !visited[ref] {
+			visited[ref] = true
+			if reaches(refs, ref, target, visited) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Returns the annotation of the field from its doc or line comments.
+func fieldAnnotation(f *ast.Field) (string, bool) {
+	for _, group := range []*ast.CommentGroup{f.Doc, f.Comment} {
+		if group == nil {
+			continue
+		}
+		for _, c := range group.List {
+			if annotation, ok := strings.CutPrefix(c.Text, annotationPrefix); ok {
+				return annotation, true
+			}
+		}
+	}
+	return "", false
+}
+
+// Returns the name of the field from its json tag, or its Go name.
+func fieldName(f *ast.Field, name string) string {
+	if f.Tag != nil {
+		tag, _ := strconv.Unquote(f.Tag.Value)
+		if jsonName, _, _ := strings.Cut(reflect.StructTag(tag).Get("json"), ","); jsonName != "" && jsonName != "-" {
+			return jsonName
+		}
+	}
+	return name
+}
+
+// Parses the annotation to the optional keyword and the validator expressions, and collects the
+// packages that the expressions refer to.
+func parseAnnotation(annotation string, used map[string]bool) (bool, []string, error) {
+	annotation = strings.TrimSpace(annotation)
+	optional := false
+	if rest, ok := strings.CutPrefix(annotation, "optional"); ok && (rest == "" || rest[0] == ' ' || rest[0] == '\t') {
+		optional, annotation = true, strings.TrimSpace(rest)
+	}
+	if annotation == "" {
+		return false, nil, fmt.Errorf("missing validators")
+	}
+	expr, err := parser.ParseExpr("f(" + annotation + ")")
+	if err != nil {
+		return false, nil, fmt.Errorf("invalid validators %q: %w", annotation, err)
+	}
+	call, ok := expr.(*ast.CallExpr)
+	if !ok || call.Ellipsis.IsValid() {
+		return false, nil, fmt.Errorf("invalid validators %q", annotation)
+	}
+	validators := make([]string, len(call.Args))
+	for i, arg := range call.Args {
+		validators[i] = exprString(arg)
+		collectPackages(arg, used)
+	}
+	return optional, validators, nil
+}
+
+// Collects the packages that the expression refers to by qualified identifiers (e.g. time.Time).
+func collectPackages(expr ast.Expr, used map[string]bool) {
+	ast.Inspect(expr, func(n ast.Node) bool {
+		if sel, ok := n.(*ast.SelectorExpr); ok {
+			if pkg, ok := sel.X.(*ast.Ident); ok {
+				used[pkg.Name] = true
+			}
+		}
+		return true
+	})
+}
+
+func exprString(expr ast.Expr) string {
+	var buf bytes.Buffer
+	printer.Fprint(&buf, token.NewFileSet(), expr)
+	return buf.String()
+}
+
+func importPath(imports map[string]string, name string) string {
+	if path, ok := imports[name]; ok {
+		return path
+	}
+	if name == "fv" {
+		return funcvalidPath
+	}
+	return name
+}
+
+// Reports if the import path is a standard library package (its first element has no dot).
+func isStd(path string) bool {
+	first, _, _ := strings.Cut(path, "/")
+	return !strings.Contains(first, ".")
+}
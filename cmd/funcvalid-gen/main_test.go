@@ -0,0 +1,80 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/go-playground/assert/v2"
+	fv "github.com/krizmak/funcvalid"
+	"github.com/krizmak/funcvalid/cmd/funcvalid-gen/internal/example"
+)
+
+var update = flag.Bool("update", false, "update the golden files")
+
+func TestGenerateGolden(t *testing.T) {
+	dir := filepath.Join("internal", "example")
+	src, err := os.ReadFile(filepath.Join(dir, "user.go"))
+	assert.Equal(t, err, nil)
+	data, err := os.ReadFile(filepath.Join(dir, "address.json"))
+	assert.Equal(t, err, nil)
+	var spec map[string]map[string]string
+	assert.Equal(t, json.Unmarshal(data, &spec), nil)
+
+	generated, err := generate("user.go", src, spec)
+	assert.Equal(t, err, nil)
+	golden := filepath.Join(dir, "user_funcvalid.go")
+	if *update {
+		assert.Equal(t, os.WriteFile(golden, generated, 0o644), nil)
+	}
+	want, err := os.ReadFile(golden)
+	assert.Equal(t, err, nil)
+	assert.Equal(t, string(generated), string(want))
+}
+
+func TestGeneratedValidators(t *testing.T) {
+	address := example.Address{Country: "HU", Zip: "1011"}
+	user := example.User{Name: "ada", Age: 36, Roles: []example.Role{"admin"}, Address: address}
+	assert.Equal(t, user.Validate(), nil)
+
+	user.Email = "ada"
+	user.Addresses = []example.Address{address, {Country: "XX", Zip: "1011", City: " Pest"}}
+	err := user.Validate()
+	assert.Equal(t, err.Error(), "error: Struct (email: error: Regexp, "+
+		"addresses: error: Each (error: Struct (country: error: Iso3166Alpha2, City: error: Trimmed)))")
+	var fieldErr *fv.FieldError
+	assert.Equal(t, errors.As(err, &fieldErr), true)
+	assert.Equal(t, fieldErr.Field, "email")
+
+	joined := example.User{Name: "ada", Age: 36, Address: address, Joined: time.Now().Add(time.Hour)}
+	assert.Equal(t, joined.Validate().Error(), "error: Struct (joined: error: notInFuture)")
+
+	billing := example.User{Name: "ada", Age: 36, Address: address, Billing: &example.Address{Country: "XX", Zip: "1011"}}
+	assert.Equal(t, billing.Validate().Error(), "error: Struct (billing: error: Struct (country: error: Iso3166Alpha2))")
+
+	category := example.Category{Name: "a", Children: []example.Category{{Name: "b", Children: []example.Category{{}}}}}
+	assert.Equal(t, category.Validate().Error(),
+		"error: Struct (children: error: Each (error: Struct (children: error: Each (error: Struct (name: error: LenBw)))))")
+	category.Children[0].Children[0].Name = "c"
+	assert.Equal(t, category.Validate(), nil)
+}
+
+func TestGenerateErrors(t *testing.T) {
+	src := []byte("package p\n\ntype T struct {\n\t//funcvalid: fv.Eq(\n\tA int\n}\n")
+	_, err := generate("t.go", src, nil)
+	assert.NotEqual(t, err, nil)
+
+	src = []byte("package p\n\ntype T struct {\n\tA int\n}\n")
+	_, err = generate("t.go", src, map[string]map[string]string{"T": {"B": "fv.Gt(1)"}})
+	assert.Equal(t, err.Error(), "spec: T.B: no such field")
+	_, err = generate("t.go", src, map[string]map[string]string{"U": {"A": "fv.Gt(1)"}})
+	assert.Equal(t, err.Error(), "spec: U: no such struct")
+
+	generated, err := generate("t.go", src, nil)
+	assert.Equal(t, err, nil)
+	assert.Equal(t, string(generated), "// Code generated by funcvalid-gen from t.go. DO NOT EDIT.\n\npackage p\n")
+}
@@ -0,0 +1,133 @@
+// Structured validation errors that carry the code of the failed rule and its parameters, so the
+// callers can inspect them (see errors.As) or render their own messages, and the wrappers that
+// override the message or the code of the errors of any validator.
+package funcvalid
+
+import (
+	"errors"
+	"strings"
+)
+
+// RuleError is the error of a failed validation rule. Its message is "error: " followed by the code,
+// like the messages of the other validators, the description parameter (if any) and the messages of
+// the failed alternatives in parentheses (if any), e.g. "error: Or (error: Eq, error: HasPrefix)".
+type RuleError struct {
+	Code   string         // the name of the rule, e.g. "HasPrefix"
+	Params map[string]any // the parameters of the rule, e.g. {"prefix": "https://"}
+	Errs   []error        // the errors of the failed alternatives of the combinators (e.g. Or)
+	Err    error          // the original error if the code is overridden (see WithCode)
+}
+
+// IndexParam is the parameter of the errors of Each (and of the other validators of the elements of
+// collections) that carries the index of the invalid element as an int, e.g. for locating the element.
+const IndexParam = "index"
+
+func (e *RuleError) Error() string {
+	msg := "error: " + e.Code
+	if description, ok := e.Params["description"]; ok {
+		msg += ": " + formatParam(description)
+	}
+	if len(e.Errs) > 0 {
+		msgs := make([]string, len(e.Errs))
+		for i, err := range e.Errs {
+			msgs[i] = err.Error()
+		}
+		msg += " (" + strings.Join(msgs, ", ") + ")"
+	}
+	return msg
+}
+
+// Returns the original error (see WithCode), or nil.
+func (e *RuleError) Unwrap() error {
+	return e.Err
+}
+
+// Reports if any of the errors of the failed alternatives matches the target, so errors.Is inspects
+// them besides the original error.
+func (e *RuleError) Is(target error) bool {
+	for _, err := range e.Errs {
+		if errors.Is(err, target) {
+			return true
+		}
+	}
+	return false
+}
+
+// Finds the first error of the failed alternatives that matches the target, so errors.As inspects
+// them besides the original error.
+func (e *RuleError) As(target any) bool {
+	for _, err := range e.Errs {
+		if errors.As(err, target) {
+			return true
+		}
+	}
+	return false
+}
+
+// MessageError is the error of a validator with a custom message (see WithMessage).
+type MessageError struct {
+	Message string
+	Err     error // the original error
+}
+
+func (e *MessageError) Error() string {
+	return e.Message
+}
+
+func (e *MessageError) Unwrap() error {
+	return e.Err
+}
+
+// Returns a RuleError with the code and the parameters given as key-value pairs.
+func ruleError(code string, keyValues ...any) error {
+	var params map[string]any
+	if len(keyValues) > 0 {
+		params = make(map[string]any, len(keyValues)/2)
+		for i := 0; i+1 < len(keyValues); i += 2 {
+			params[keyValues[i].(string)] = keyValues[i+1]
+		}
+	}
+	return &RuleError{Code: code, Params: params}
+}
+
+// Factory function with a validator and a message parameter that returns a validator, that validates
+// the input with the parameter validator, and replaces the message of its error with the parameter.
+// The original error is available with errors.Unwrap.
+func WithMessage[T any](validator Validator[T], msg string) Validator[T] {
+	return withRule(compositeRule("WithMessage", []Validator[T]{validator}, "message", msg), func(inp T) error {
+		if err := validator(inp); err != nil {
+			return &MessageError{Message: msg, Err: err}
+		}
+		return nil
+	})
+}
+
+// Factory function with a validator and a code parameter that returns a validator, that validates the
+// input with the parameter validator, and replaces its error with a RuleError of the code. The parameters
+// of the original RuleError (if any) are kept, and the original error is available with errors.Unwrap.
+func WithCode[T any](validator Validator[T], code string) Validator[T] {
+	return withRule(compositeRule("WithCode", []Validator[T]{validator}, "code", code), func(inp T) error {
+		err := validator(inp)
+		if err == nil {
+			return nil
+		}
+		result := &RuleError{Code: code, Err: err}
+		var ruleErr *RuleError
+		if errors.As(err, &ruleErr) {
+			result.Params = ruleErr.Params
+		}
+		return result
+	})
+}
+
+// Factory function with a validator and an error function parameter that returns a validator, that
+// validates the input with the parameter validator, and replaces its error with the result of the error
+// function called with the input and the original error (that can be wrapped with the %w verb of fmt.Errorf).
+func WithErrorf[T any](validator Validator[T], errorf func(inp T, err error) error) Validator[T] {
+	return withRule(compositeRule("WithErrorf", []Validator[T]{validator}), func(inp T) error {
+		if err := validator(inp); err != nil {
+			return errorf(inp, err)
+		}
+		return nil
+	})
+}
@@ -3,6 +3,7 @@ package funcvalid
 import (
 	"errors"
 	"regexp"
+	"strings"
 
 	"golang.org/x/exp/constraints"
 )
@@ -13,183 +14,275 @@ type Validator[T any] func(inp T) error
 // Factory function with a paramter that returns a validator, that
 // validates if the input value equals to the parameter.
 func Eq[T comparable](pattern T) Validator[T] {
-	return func(inp T) error {
+	return withRule(newRule("Eq", "value", pattern), func(inp T) error {
 		if inp == pattern {
 			return nil
 		}
-		return errors.New("error: Eq")
-	}
+		return ruleError("Eq", "value", pattern)
+	})
 }
 
 // Factory function with a parameter that returns a validator, that
 // validates if the input value less than the parameter.
 func Lt[T constraints.Ordered](pattern T) Validator[T] {
-	return func(inp T) error {
+	return withRule(newRule("Lt", "value", pattern), func(inp T) error {
 		if inp < pattern {
 			return nil
 		}
-		return errors.New("error: Lt")
-	}
+		return ruleError("Lt", "value", pattern)
+	})
 }
 
 // Factory function with a parameter that returns a validator, that
 // validates if the input value less than the parameter.
 func Gt[T constraints.Ordered](pattern T) Validator[T] {
-	return func(inp T) error {
+	return withRule(newRule("Gt", "value", pattern), func(inp T) error {
 		if inp > pattern {
 			return nil
 		}
-		return errors.New("error: Gt")
-	}
+		return ruleError("Gt", "value", pattern)
+	})
 }
 
 // Factory function with a regexp string parameter that returns a validator, that
 // validates if the input value matches to the regexp.
 func Regexp(pattern string) Validator[string] {
-	return func(inp string) error {
+	return withRule(newRule("Regexp", "pattern", pattern), func(inp string) error {
 		matched, err := regexp.MatchString(pattern, inp)
 		if (err == nil) && (matched) {
 			return nil
 		}
-		return errors.New("error: Regexp")
-	}
+		return ruleError("Regexp", "pattern", pattern)
+	})
 }
 
 // Factory function with a regexp parameter that returns a validator, that
 // validates if the input value matches to the regexp.
 func RegexpRE(pattern *regexp.Regexp) Validator[string] {
-	return func(inp string) error {
+	return withRule(newRule("Regexp", "pattern", pattern.String()), func(inp string) error {
 		if pattern.MatchString(inp) {
 			return nil
 		}
-		return errors.New("error: Regexp")
-	}
+		return ruleError("Regexp", "pattern", pattern.String())
+	})
 }
 
 // Factory function with a parameter that returns a validator, that
-// validates if the length of the input array or string equals to the parameter.
-func LenEq[T string | []T](length int) Validator[T] {
-	return func(inp T) error {
+// validates if the length of the input array, byte slice or string equals to the parameter.
+func LenEq[T string | []byte | []T](length int) Validator[T] {
+	return withRule(newRule("LenEq", "length", length), func(inp T) error {
 		if len(inp) == length {
 			return nil
 		}
-		return errors.New("error: LenEq")
-	}
+		return ruleError("LenEq", "length", length)
+	})
 }
 
 // Factory function with two parameters that returns a validator, that
-// validates if the length of the input array or string is between the two parameters.
-func LenBw[T string | []T](min int, max int) Validator[T] {
-	return func(inp T) error {
+// validates if the length of the input array, byte slice or string is between the two parameters.
+func LenBw[T string | []byte | []T](min int, max int) Validator[T] {
+	return withRule(newRule("LenBw", "min", min, "max", max), func(inp T) error {
 		if (min <= len(inp)) && (len(inp) <= max) {
 			return nil
 		}
-		return errors.New("error: LenBw")
-	}
+		return ruleError("LenBw", "min", min, "max", max)
+	})
 }
 
 // Factory function with a parameter that returns a validator, that
-// validates if the length of the input array or string less than the parameter.
-func LenLt[T string | []T](length int) Validator[T] {
-	return func(inp T) error {
+// validates if the length of the input array, byte slice or string less than the parameter.
+func LenLt[T string | []byte | []T](length int) Validator[T] {
+	return withRule(newRule("LenLt", "length", length), func(inp T) error {
 		if len(inp) < length {
 			return nil
 		}
-		return errors.New("error: LenLt")
-	}
+		return ruleError("LenLt", "length", length)
+	})
 }
 
 // Factory function with a parameter that returns a validator, that
-// validates if the length of the input array or string greater than the parameter.
-func LenGt[T string | []T](length int) Validator[T] {
-	return func(inp T) error {
+// validates if the length of the input array, byte slice or string greater than the parameter.
+func LenGt[T string | []byte | []T](length int) Validator[T] {
+	return withRule(newRule("LenGt", "length", length), func(inp T) error {
 		if len(inp) > length {
 			return nil
 		}
-		return errors.New("error: LenGt")
-	}
+		return ruleError("LenGt", "length", length)
+	})
 }
 
 // Factory function with a number of parameters that returns a validator, that validates
 // if the input is one of the values in the parameters.
 func OneOf[T comparable](elems ...T) Validator[T] {
-	return func(inp T) error {
+	return withRule(newRule("OneOf", "values", elems), func(inp T) error {
 		for _, e := range elems {
 			if e == inp {
 				return nil
 			}
 		}
-		return errors.New("error: OneOf")
-	}
+		return ruleError("OneOf", "values", elems)
+	})
 }
 
 // Factory function with a map parameter that returns a validator, that validates
 // if the input is one of the keys in the map.
 func KeyIn[K comparable, V any](validmap map[K]V) Validator[K] {
-	return func(inp K) error {
+	return withRule(newRule("IsKeyIn"), func(inp K) error {
 		if _, ok := validmap[inp]; ok {
 			return nil
 		}
-		return errors.New("error: IsKeyIn")
-	}
+		return ruleError("IsKeyIn")
+	})
 }
 
 // Factory function with a map parameter that returns a validator, that validates
 // if the input is one of the values in the map.
 func ValueIn[K comparable, V comparable](validmap map[K]V) Validator[V] {
-	return func(inp V) error {
+	return withRule(newRule("IsValueIn"), func(inp V) error {
 		for _, value := range validmap {
 			if value == inp {
 				return nil
 			}
 		}
-		return errors.New("error: IsValueIn")
-	}
+		return ruleError("IsValueIn")
+	})
 }
 
 // Factory function with a string parameter that returns a validator, that always return
 // error with the message in the parameter.
 func ErrorValidator[T any](error_msg string) Validator[T] {
-	return func(inp T) error {
+	return withRule(newRule("ErrorValidator", "message", error_msg), func(inp T) error {
 		return errors.New("error: " + error_msg)
-	}
+	})
 }
 
-// Factory function that takes variable number of validators, and returns a validator
-// that validates if all the parameter validators are valid.
-func Not[T any](validator Validator[T]) Validator[T] {
-	return func(inp T) error {
+// Factory function that takes a validator and an optional description of what is negated, and returns
+// a validator that validates if the parameter validator is not valid. The error carries the description
+// as its description parameter, e.g. "error: Not: a reserved name".
+func Not[T any](validator Validator[T], description ...string) Validator[T] {
+	return withRule(notRule(validator, description), func(inp T) error {
 		if err := validator(inp); err != nil {
 			return nil
 		}
-		return errors.New("error: Not")
-	}
+		if len(description) > 0 {
+			return ruleError("Not", "description", strings.Join(description, " "))
+		}
+		return ruleError("Not")
+	})
 }
 
 // Factory function that takes variable number of validators, and returns a validator
 // that validates if all the parameter validators are valid.
 func And[T any](validators ...Validator[T]) Validator[T] {
-	return func(inp T) error {
+	return withRule(compositeRule("And", validators), func(inp T) error {
 		for _, v := range validators {
 			if err := v(inp); err != nil {
 				return err
 			}
 		}
 		return nil
-	}
+	})
 }
 
 // Factory function that takes variable number of validators, and returns a validator
-// that validates if any of the parameter validators are valid.
+// that validates if any of the parameter validators are valid. The error lists the errors
+// of all the alternatives.
 func Or[T any](validators ...Validator[T]) Validator[T] {
-	return func(inp T) error {
+	return withRule(compositeRule("Or", validators), func(inp T) error {
+		var errs []error
 		for _, v := range validators {
-			if err := v(inp); err == nil {
+			err := v(inp)
+			if err == nil {
 				return nil
 			}
+			errs = append(errs, err)
+		}
+		return &RuleError{Code: "Or", Errs: errs}
+	})
+}
+
+// Factory function that takes variable number of validators, and returns a validator
+// that validates if exactly one of the parameter validators is valid. If none of them is
+// valid, the error lists the errors of all the alternatives.
+func OneOfValidators[T any](validators ...Validator[T]) Validator[T] {
+	return withRule(compositeRule("OneOfValidators", validators), func(inp T) error {
+		passed, errs := countValid(inp, validators)
+		if passed == 1 {
+			return nil
+		}
+		if passed > 1 {
+			return ruleError("OneOfValidators", "passed", passed)
+		}
+		return &RuleError{Code: "OneOfValidators", Params: map[string]any{"passed": passed}, Errs: errs}
+	})
+}
+
+// Factory function that takes a number and variable number of validators, and returns a
+// validator that validates if at least n of the parameter validators are valid. The error
+// lists the errors of the alternatives that are not valid.
+func AtLeast[T any](n int, validators ...Validator[T]) Validator[T] {
+	return withRule(compositeRule("AtLeast", validators, "count", n), func(inp T) error {
+		passed, errs := countValid(inp, validators)
+		if passed >= n {
+			return nil
+		}
+		return &RuleError{Code: "AtLeast", Params: map[string]any{"count": n, "passed": passed}, Errs: errs}
+	})
+}
+
+// Factory function that takes a number and variable number of validators, and returns a
+// validator that validates if at most n of the parameter validators are valid.
+func AtMost[T any](n int, validators ...Validator[T]) Validator[T] {
+	return withRule(compositeRule("AtMost", validators, "count", n), func(inp T) error {
+		if passed, _ := countValid(inp, validators); passed > n {
+			return ruleError("AtMost", "count", n, "passed", passed)
+		}
+		return nil
+	})
+}
+
+// Factory function that takes a validator, and returns a validator that validates if all
+// the elements of the input slice are valid by the parameter validator. The error carries the
+// index of the first invalid element (see IndexParam), and its error.
+func Each[T any](validator Validator[T]) Validator[[]T] {
+	rule := Rule{
+		Name:     "Each",
+		Params:   map[string]any{"type": jsonTypeOf(typeFor[T]())},
+		Children: []Rule{RuleOf(validator)},
+	}
+	return withRule(rule, func(inp []T) error {
+		for i, elem := range inp {
+			if err := validator(elem); err != nil {
+				return &RuleError{Code: "Each", Params: map[string]any{IndexParam: i}, Errs: []error{err}}
+			}
+		}
+		return nil
+	})
+}
+
+// Factory function that takes a validator, and returns a validator that validates if the value that
+// the input pointer points to is valid by the parameter validator. The nil pointers are valid (see
+// OptionalField).
+func Deref[T any](validator Validator[T]) Validator[*T] {
+	return withRule(compositeRule("Deref", []Validator[T]{validator}), func(inp *T) error {
+		if inp == nil {
+			return nil
+		}
+		return validator(*inp)
+	})
+}
+
+func countValid[T any](inp T, validators []Validator[T]) (int, []error) {
+	passed := 0
+	var errs []error
+	for _, v := range validators {
+		if err := v(inp); err != nil {
+			errs = append(errs, err)
+		} else {
+			passed++
 		}
-		return errors.New("error: Or")
 	}
+	return passed, errs
 }
 
 // Helper function that takes variable number of errors or nils, and returns an err
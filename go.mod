@@ -6,4 +6,5 @@ require (
 	github.com/go-playground/assert/v2 v2.2.0
 	github.com/leodido/go-urn v1.2.4
 	golang.org/x/exp v0.0.0-20230817173708-d852ddb80c63
+	golang.org/x/text v0.21.0
 )
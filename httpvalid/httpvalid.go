@@ -0,0 +1,200 @@
+// Package httpvalid is the net/http glue of the funcvalid validators: it decodes and validates the
+// JSON bodies, the query parameters and the headers of the requests, and reports the errors as RFC 9457
+// problem details (application/problem+json) that list the invalid fields:
+//
+//	validateLogin := fv.Struct(
+//		fv.Field("username", func(l Login) string { return l.Username }, fv.LenBw[string](1, 30)),
+//		fv.Field("password", func(l Login) string { return l.Password }, fv.LenBw[string](7, 32)))
+//
+//	http.Handle("POST /login", httpvalid.Handler(validateLogin, func(w http.ResponseWriter, r *http.Request, l Login) {
+//		...
+//	}))
+package httpvalid
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"strings"
+
+	fv "github.com/krizmak/funcvalid"
+)
+
+// MaxBodyBytes is the maximum size of the request bodies that DecodeAndValidate reads.
+const MaxBodyBytes = 1 << 20
+
+// RequestError is the error of a request that can't be decoded, with the HTTP status of the response
+// (e.g. 415 for an unsupported content type).
+type RequestError struct {
+	Status int
+	Err    error
+}
+
+func (e *RequestError) Error() string {
+	return "error: request: " + e.Err.Error()
+}
+
+func (e *RequestError) Unwrap() error {
+	return e.Err
+}
+
+// ValidationError is the error of a decoded request that is invalid by the validator.
+type ValidationError struct {
+	Err error
+}
+
+func (e *ValidationError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *ValidationError) Unwrap() error {
+	return e.Err
+}
+
+// Decodes the JSON body of the request, and validates it with the validator. The error is a
+// RequestError if the body isn't a single JSON value of the T type (or it's larger than MaxBodyBytes),
+// or a ValidationError if it's invalid by the validator.
+func DecodeAndValidate[T any](r *http.Request, v fv.Validator[T]) (T, error) {
+	var body T
+	if contentType := r.Header.Get("Content-Type"); contentType != "" {
+		mediaType, _, err := mime.ParseMediaType(contentType)
+		if err != nil || (mediaType != "application/json" && !strings.HasSuffix(mediaType, "+json")) {
+			return body, &RequestError{Status: http.StatusUnsupportedMediaType, Err: fmt.Errorf("unsupported content type %q", contentType)}
+		}
+	}
+	if r.Body == nil {
+		return body, &RequestError{Status: http.StatusBadRequest, Err: errors.New("missing body")}
+	}
+	data, err := io.ReadAll(io.LimitReader(r.Body, MaxBodyBytes+1))
+	if err != nil {
+		return body, &RequestError{Status: http.StatusBadRequest, Err: err}
+	}
+	if len(data) > MaxBodyBytes {
+		return body, &RequestError{Status: http.StatusRequestEntityTooLarge, Err: errors.New("body too large")}
+	}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	if err := dec.Decode(&body); err != nil {
+		return body, &RequestError{Status: http.StatusBadRequest, Err: err}
+	}
+	if dec.More() {
+		return body, &RequestError{Status: http.StatusBadRequest, Err: errors.New("trailing data after the JSON value")}
+	}
+	if err := v(body); err != nil {
+		return body, &ValidationError{Err: err}
+	}
+	return body, nil
+}
+
+// Returns a handler that decodes and validates the JSON body of the requests (see DecodeAndValidate),
+// and calls the handle function with the valid bodies, or writes the problem details of the error.
+func Handler[T any](v fv.Validator[T], handle func(w http.ResponseWriter, r *http.Request, body T), opts ...ProblemOption) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := DecodeAndValidate(r, v)
+		if err != nil {
+			WriteProblem(w, r, err, opts...)
+			return
+		}
+		handle(w, r, body)
+	})
+}
+
+// Returns a middleware that validates the requests with the validator (e.g. with Params), and calls
+// the next handler with the valid requests, or writes the problem details of the error.
+func Middleware(v fv.Validator[*http.Request], opts ...ProblemOption) func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if err := v(r); err != nil {
+				WriteProblem(w, r, &ValidationError{Err: err}, opts...)
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// ParamError is the error of a query parameter or a header of a request.
+type ParamError struct {
+	In   string // "query" or "header"
+	Name string
+	Err  error
+}
+
+func (e *ParamError) Error() string {
+	return e.In + " " + e.Name + ": " + e.Err.Error()
+}
+
+func (e *ParamError) Unwrap() error {
+	return e.Err
+}
+
+// Param is the validator of a query parameter or a header of the requests (see Params).
+type Param struct {
+	in       string
+	name     string
+	required bool
+	validate fv.Validator[string]
+}
+
+// Returns the validator of the required query parameter with the name, that validates all its values.
+func Query(name string, v fv.Validator[string]) Param {
+	return Param{in: "query", name: name, required: true, validate: v}
+}
+
+// Returns the validator of the optional query parameter with the name, that validates all its values.
+func OptionalQuery(name string, v fv.Validator[string]) Param {
+	return Param{in: "query", name: name, validate: v}
+}
+
+// Returns the validator of the required header with the name, that validates all its values.
+func Header(name string, v fv.Validator[string]) Param {
+	return Param{in: "header", name: http.CanonicalHeaderKey(name), required: true, validate: v}
+}
+
+// Returns the validator of the optional header with the name, that validates all its values.
+func OptionalHeader(name string, v fv.Validator[string]) Param {
+	return Param{in: "header", name: http.CanonicalHeaderKey(name), validate: v}
+}
+
+// Factory function that takes variable number of parameter validators, and returns a validator that
+// validates if all the query parameters and headers of the input request are valid. The error lists
+// the ParamErrors of all the invalid parameters.
+func Params(params ...Param) fv.Validator[*http.Request] {
+	rule := fv.Rule{Name: "Params"}
+	for _, p := range params {
+		rule.Children = append(rule.Children, fv.Rule{
+			Name:     "Param",
+			Params:   map[string]any{"in": p.in, "name": p.name, "required": p.required},
+			Children: []fv.Rule{fv.RuleOf(p.validate)},
+		})
+	}
+	return fv.WithRule(func(r *http.Request) error {
+		query := r.URL.Query()
+		var errs []error
+		for _, p := range params {
+			values := r.Header.Values(p.name)
+			if p.in == "query" {
+				values = query[p.name]
+			}
+			if len(values) == 0 {
+				if p.required {
+					errs = append(errs, &ParamError{In: p.in, Name: p.name, Err: &fv.RuleError{Code: "Required"}})
+				}
+				continue
+			}
+			for _, value := range values {
+				if err := p.validate(value); err != nil {
+					errs = append(errs, &ParamError{In: p.in, Name: p.name, Err: err})
+					break
+				}
+			}
+		}
+		if len(errs) > 0 {
+			return &fv.RuleError{Code: "Params", Errs: errs}
+		}
+		return nil
+	}, rule)
+}
@@ -0,0 +1,128 @@
+package httpvalid_test
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/go-playground/assert/v2"
+	fv "github.com/krizmak/funcvalid"
+	"github.com/krizmak/funcvalid/httpvalid"
+)
+
+type order struct {
+	Email string   `json:"email"`
+	Items []string `json:"items"`
+}
+
+var validateOrder = fv.Struct(
+	fv.Field("email", func(o order) string { return o.Email }, fv.Email),
+	fv.Field("items", func(o order) []string { return o.Items }, fv.Each(fv.LenBw[string](1, 10))))
+
+func serve(h http.Handler, r *http.Request) (*httptest.ResponseRecorder, httpvalid.Problem) {
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+	var p httpvalid.Problem
+	if w.Header().Get("Content-Type") == "application/problem+json" {
+		_ = json.Unmarshal(w.Body.Bytes(), &p)
+	}
+	return w, p
+}
+
+func TestDecodeAndValidate(t *testing.T) {
+	r := httptest.NewRequest("POST", "/orders", strings.NewReader(`{"email": "a@example.com", "items": ["book"]}`))
+	o, err := httpvalid.DecodeAndValidate(r, validateOrder)
+	assert.Equal(t, err, nil)
+	assert.Equal(t, o, order{Email: "a@example.com", Items: []string{"book"}})
+
+	r = httptest.NewRequest("POST", "/orders", strings.NewReader(`{"email": "a"}`))
+	_, err = httpvalid.DecodeAndValidate(r, validateOrder)
+	var validationErr *httpvalid.ValidationError
+	assert.Equal(t, errors.As(err, &validationErr), true)
+
+	for body, status := range map[string]int{`{"email": 1}`: 400, `{} {}`: 400, `{`: 400,
+		`"` + strings.Repeat("x", httpvalid.MaxBodyBytes) + `"`: 413} {
+		r = httptest.NewRequest("POST", "/orders", strings.NewReader(body))
+		_, err = httpvalid.DecodeAndValidate(r, validateOrder)
+		var requestErr *httpvalid.RequestError
+		assert.Equal(t, errors.As(err, &requestErr), true)
+		assert.Equal(t, requestErr.Status, status)
+	}
+	r = httptest.NewRequest("POST", "/orders", strings.NewReader(`email=a`))
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	_, err = httpvalid.DecodeAndValidate(r, validateOrder)
+	assert.Equal(t, err.Error(), `error: request: unsupported content type "application/x-www-form-urlencoded"`)
+}
+
+func TestHandler(t *testing.T) {
+	h := httpvalid.Handler(validateOrder, func(w http.ResponseWriter, r *http.Request, o order) {
+		w.WriteHeader(http.StatusCreated)
+	})
+	r := httptest.NewRequest("POST", "/orders", strings.NewReader(`{"email": "a@example.com", "items": ["book"]}`))
+	r.Header.Set("Content-Type", "application/json; charset=utf-8")
+	w, _ := serve(h, r)
+	assert.Equal(t, w.Code, http.StatusCreated)
+
+	r = httptest.NewRequest("POST", "/orders", strings.NewReader(`{"email": "a", "items": ["book", ""]}`))
+	r.Header.Set("Accept-Language", "de-AT, en;q=0.5")
+	w, p := serve(h, r)
+	assert.Equal(t, w.Code, http.StatusUnprocessableEntity)
+	assert.Equal(t, p, httpvalid.Problem{Title: "Unprocessable Entity", Status: 422, Errors: []httpvalid.FieldProblem{
+		{Pointer: "#/email", Code: "Regexp", Detail: "hat ein ungültiges Format"},
+		{Pointer: "#/items/1", Code: "LenBw", Detail: "muss eine Länge zwischen 1 und 10 haben"},
+	}})
+
+	w, p = serve(h, httptest.NewRequest("POST", "/orders", strings.NewReader(`[]`)))
+	assert.Equal(t, w.Code, http.StatusBadRequest)
+	assert.Equal(t, p.Title, "Bad Request")
+	assert.NotEqual(t, p.Detail, "")
+
+	translator := fv.NewTranslator()
+	translator.AddCatalog("en", fv.Catalog{"Regexp": "is not a valid email address"})
+	h = httpvalid.Handler(validateOrder, func(w http.ResponseWriter, r *http.Request, o order) {},
+		httpvalid.ProblemTranslator(translator))
+	_, p = serve(h, httptest.NewRequest("POST", "/orders", strings.NewReader(`{"email": "a"}`)))
+	assert.Equal(t, p.Errors[0].Detail, "is not a valid email address")
+}
+
+func TestParams(t *testing.T) {
+	validateRequest := httpvalid.Params(
+		httpvalid.Query("page", fv.Numeric),
+		httpvalid.OptionalQuery("sort", fv.OneOf("asc", "desc")),
+		httpvalid.Header("x-request-id", fv.UUID4))
+	h := httpvalid.Middleware(validateRequest)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	r := httptest.NewRequest("GET", "/orders?page=2", nil)
+	r.Header.Set("X-Request-ID", "0b9e3f5c-7c1e-4c62-9b5c-2f3f4d5e6a7b")
+	w, _ := serve(h, r)
+	assert.Equal(t, w.Code, http.StatusNoContent)
+
+	w, p := serve(h, httptest.NewRequest("GET", "/orders?page=2&sort=asc&sort=up", nil))
+	assert.Equal(t, w.Code, http.StatusUnprocessableEntity)
+	assert.Equal(t, w.Header().Get("Content-Type"), "application/problem+json")
+	assert.Equal(t, p.Errors, []httpvalid.FieldProblem{
+		{Parameter: "sort", Code: "OneOf", Detail: "must be one of asc, desc"},
+		{Header: "X-Request-Id", Code: "Required", Detail: "is required"},
+	})
+	assert.Equal(t, validateRequest(httptest.NewRequest("GET", "/orders", nil)).Error(),
+		"error: Params (query page: error: Required, header X-Request-Id: error: Required)")
+}
+
+func TestProblemOf(t *testing.T) {
+	validatePayload, err := fv.CompileJSONSchema([]byte(`{"properties": {"a b": {"type": "array", "items": {"type": "integer"}}}}`))
+	assert.Equal(t, err, nil)
+	r := httptest.NewRequest("POST", "/", nil)
+	p := httpvalid.ProblemOf(r, &httpvalid.ValidationError{Err: validatePayload(json.RawMessage(`{"a b": [1, "2"]}`))})
+	assert.Equal(t, p.Errors, []httpvalid.FieldProblem{{Pointer: "#/a%20b/1", Code: "Type", Detail: "must be of type integer"}})
+	bindPage := fv.BindValues(fv.Value("page", fv.Int, func(p *int) *int { return p }, fv.Gt(0)))
+	_, err = bindPage(url.Values{"page": {"x"}})
+	p = httpvalid.ProblemOf(r, &httpvalid.ValidationError{Err: err})
+	assert.Equal(t, p.Errors, []httpvalid.FieldProblem{{Parameter: "page", Code: "Int", Detail: "must be an integer"}})
+	p = httpvalid.ProblemOf(r, errors.New("database is down"))
+	assert.Equal(t, p, httpvalid.Problem{Title: "Internal Server Error", Status: 500})
+}
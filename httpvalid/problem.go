@@ -0,0 +1,149 @@
+// RFC 9457 problem details of the request and validation errors.
+package httpvalid
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	fv "github.com/krizmak/funcvalid"
+)
+
+// Problem is the RFC 9457 problem details of an error, with the errors of the invalid fields and
+// parameters as the errors extension member.
+type Problem struct {
+	Type   string         `json:"type,omitempty"`
+	Title  string         `json:"title"`
+	Status int            `json:"status"`
+	Detail string         `json:"detail,omitempty"`
+	Errors []FieldProblem `json:"errors,omitempty"`
+}
+
+// FieldProblem is the error of an invalid field (located by a JSON Pointer in URI fragment form, e.g.
+// "#/items/0/name"), a query parameter or a header of the request.
+type FieldProblem struct {
+	Pointer   string `json:"pointer,omitempty"`
+	Parameter string `json:"parameter,omitempty"`
+	Header    string `json:"header,omitempty"`
+	Code      string `json:"code,omitempty"` // the code of the failed rule, e.g. "LenBw"
+	Detail    string `json:"detail"`
+}
+
+type problemConfig struct {
+	translator *fv.Translator
+}
+
+// ProblemOption is an option of the problem details (see Handler, Middleware and WriteProblem).
+type ProblemOption func(c *problemConfig)
+
+// Option with a translator parameter that translates the details of the field errors (in the locale
+// of the Accept-Language header of the requests). By default they are translated by the bundled
+// catalogs of funcvalid.
+func ProblemTranslator(t *fv.Translator) ProblemOption {
+	return func(c *problemConfig) {
+		c.translator = t
+	}
+}
+
+// Returns the problem details of the error of the request: 422 Unprocessable Entity with the errors of
+// the invalid fields and parameters for the validation errors, the status of the RequestErrors, or 500
+// Internal Server Error for the other errors (without their details).
+func ProblemOf(r *http.Request, err error, opts ...ProblemOption) Problem {
+	var c problemConfig
+	for _, opt := range opts {
+		opt(&c)
+	}
+	var requestErr *RequestError
+	var validationErr *ValidationError
+	switch {
+	case errors.As(err, &requestErr):
+		return Problem{Title: http.StatusText(requestErr.Status), Status: requestErr.Status, Detail: requestErr.Err.Error()}
+	case errors.As(err, &validationErr):
+		p := Problem{Title: http.StatusText(http.StatusUnprocessableEntity), Status: http.StatusUnprocessableEntity}
+		locale := acceptedLanguage(r)
+		collectProblems(validationErr.Err, "", func(fp FieldProblem, err error) {
+			var ruleErr *fv.RuleError
+			if errors.As(err, &ruleErr) {
+				fp.Code = ruleErr.Code
+			}
+			if c.translator == nil {
+				c.translator = fv.NewTranslator()
+			}
+			fp.Detail = c.translator.Translate(err, locale)
+			p.Errors = append(p.Errors, fp)
+		})
+		return p
+	}
+	return Problem{Title: http.StatusText(http.StatusInternalServerError), Status: http.StatusInternalServerError}
+}
+
+// Writes the problem details of the error of the request (see ProblemOf) as an application/problem+json
+// response.
+func WriteProblem(w http.ResponseWriter, r *http.Request, err error, opts ...ProblemOption) {
+	p := ProblemOf(r, err, opts...)
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(p.Status)
+	_ = json.NewEncoder(w).Encode(p)
+}
+
+// Collects the errors of the fields and parameters of the error tree with their locations.
+func collectProblems(err error, pointer string, add func(fp FieldProblem, err error)) {
+	switch e := err.(type) {
+	case *fv.FieldError:
+		collectProblems(e.Err, pointer+"/"+escapePointerToken(e.Field), add)
+		return
+	case *fv.PointerError:
+		collectProblems(e.Err, pointer+e.Pointer, add)
+		return
+	case *ParamError:
+		if e.In == "query" {
+			add(FieldProblem{Parameter: e.Name}, e.Err)
+		} else {
+			add(FieldProblem{Header: e.Name}, e.Err)
+		}
+		return
+	case *fv.RuleError:
+		switch e.Code {
+		case "Values":
+			// the FieldErrors of the parameters bound by funcvalid.BindValues
+			for _, nested := range e.Errs {
+				if fieldErr, ok := nested.(*fv.FieldError); ok {
+					add(FieldProblem{Parameter: fieldErr.Field}, fieldErr.Err)
+				} else {
+					collectProblems(nested, pointer, add)
+				}
+			}
+			return
+		case "Struct", "JSONSchema", "Params":
+			for _, nested := range e.Errs {
+				collectProblems(nested, pointer, add)
+			}
+			return
+		case "Each":
+			if len(e.Errs) == 1 {
+				if i, ok := e.Params[fv.IndexParam].(int); ok {
+					collectProblems(e.Errs[0], pointer+"/"+strconv.Itoa(i), add)
+					return
+				}
+			}
+		}
+	}
+	add(FieldProblem{Pointer: "#" + (&url.URL{Fragment: pointer}).EscapedFragment()}, err)
+}
+
+func escapePointerToken(token string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(token, "~", "~0"), "/", "~1")
+}
+
+// Returns the first language of the Accept-Language header of the request, or "en".
+func acceptedLanguage(r *http.Request) string {
+	first, _, _ := strings.Cut(r.Header.Get("Accept-Language"), ",")
+	lang, _, _ := strings.Cut(first, ";")
+	if lang = strings.TrimSpace(lang); lang == "" || lang == "*" {
+		return "en"
+	}
+	return lang
+}
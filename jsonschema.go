@@ -0,0 +1,251 @@
+// JSON Schema (draft 2020-12) export of the validators from their rules (see RuleOf), e.g. for
+// frontend validation and OpenAPI documents:
+//
+//	schema := fv.JSONSchema(fv.Struct(
+//		fv.Field("email", func(r Req) string { return r.Email }, fv.Email),
+//		fv.OptionalField("tags", func(r Req) []string { return r.Tags }, fv.Each(fv.LenBw[string](1, 20)))))
+//	json.Marshal(schema)
+//
+// The rules without JSON Schema equivalent are reported as "x-" extension keywords with their
+// parameters, e.g. {"x-Iso3166Alpha2": true} or {"x-PhoneNumber": {"region": "HU"}}. The patterns
+// are in the regexp syntax of Go, that is mostly compatible with the ECMA 262 syntax of JSON Schema.
+package funcvalid
+
+import (
+	"reflect"
+	"regexp"
+)
+
+// Returns the JSON Schema of the validator as a JSON-serializable map.
+func JSONSchema[T any](v Validator[T]) map[string]any {
+	schema := typedSchema(RuleOf(v), jsonTypeOf(typeFor[T]()))
+	schema["$schema"] = "https://json-schema.org/draft/2020-12/schema"
+	return schema
+}
+
+// The JSON Schema formats of the built-in regexps.
+var regexpFormats = map[string]string{
+	emailRegexString:           "email",
+	uUIDRegexString:            "uuid",
+	uUID3RegexString:           "uuid",
+	uUID4RegexString:           "uuid",
+	uUID5RegexString:           "uuid",
+	uUIDRFC4122RegexString:     "uuid",
+	uUID3RFC4122RegexString:    "uuid",
+	uUID4RFC4122RegexString:    "uuid",
+	uUID5RFC4122RegexString:    "uuid",
+	hostnameRegexStringRFC952:  "hostname",
+	hostnameRegexStringRFC1123: "hostname",
+}
+
+// The JSON Schema formats of the validators without parameters.
+var ruleFormats = map[string]string{
+	"Url":                "uri",
+	"HttpUrl":            "uri",
+	"URI":                "uri",
+	"EmailWith":          "email",
+	"UUIDVersion":        "uuid",
+	"UUIDVariantRFC9562": "uuid",
+	"JSONPointer":        "json-pointer",
+	"Ipv4":               "ipv4",
+	"Ipv6":               "ipv6",
+}
+
+// The keywords of the rules of the compiled JSON Schemas (see CompileJSONSchema).
+var ruleKeywords = map[string]string{
+	"Type":          "type",
+	"Minimum":       "minimum",
+	"Maximum":       "maximum",
+	"MultipleOf":    "multipleOf",
+	"MinLength":     "minLength",
+	"MaxLength":     "maxLength",
+	"MinItems":      "minItems",
+	"MaxItems":      "maxItems",
+	"MinProperties": "minProperties",
+	"MaxProperties": "maxProperties",
+}
+
+// Returns the schema of the rule with the type keyword (if the JSON type is known).
+func typedSchema(rule Rule, jsonType string) map[string]any {
+	schema := ruleSchema(rule, jsonType)
+	if jsonType != "" {
+		if _, ok := schema["type"]; !ok {
+			schema = mergeSchemas(map[string]any{"type": jsonType}, schema)
+		}
+	}
+	return schema
+}
+
+// Returns the schema of the rule without the type keyword. The length keywords depend on the JSON type.
+func ruleSchema(rule Rule, jsonType string) map[string]any {
+	p := rule.Params
+	children := func() []any {
+		schemas := make([]any, len(rule.Children))
+		for i, child := range rule.Children {
+			schemas[i] = ruleSchema(child, jsonType)
+		}
+		return schemas
+	}
+	minKey, maxKey := "minLength", "maxLength"
+	switch jsonType {
+	case "array":
+		minKey, maxKey = "minItems", "maxItems"
+	case "object":
+		minKey, maxKey = "minProperties", "maxProperties"
+	}
+	switch rule.Name {
+	case "Eq":
+		return map[string]any{"const": p["value"]}
+	case "Lt", "Gt":
+		if isNumber(p["value"]) {
+			if rule.Name == "Lt" {
+				return map[string]any{"exclusiveMaximum": p["value"]}
+			}
+			return map[string]any{"exclusiveMinimum": p["value"]}
+		}
+	case "OneOf":
+		return map[string]any{"enum": p["values"]}
+	case "LenEq":
+		return map[string]any{minKey: p["length"], maxKey: p["length"]}
+	case "LenBw":
+		return map[string]any{minKey: p["min"], maxKey: p["max"]}
+	case "LenLt":
+		if length, ok := p["length"].(int); ok {
+			return map[string]any{maxKey: length - 1}
+		}
+	case "LenGt":
+		if length, ok := p["length"].(int); ok {
+			return map[string]any{minKey: length + 1}
+		}
+	case "Regexp":
+		if pattern, ok := p["pattern"].(string); ok {
+			if format := regexpFormats[pattern]; format == "email" {
+				return map[string]any{"format": format}
+			} else if format != "" {
+				return map[string]any{"format": format, "pattern": pattern}
+			}
+			return map[string]any{"pattern": pattern}
+		}
+	case "HasPrefix":
+		if prefix, ok := p["prefix"].(string); ok {
+			return map[string]any{"pattern": "^" + regexp.QuoteMeta(prefix)}
+		}
+	case "HasSuffix":
+		if suffix, ok := p["suffix"].(string); ok {
+			return map[string]any{"pattern": regexp.QuoteMeta(suffix) + "$"}
+		}
+	case "Contains":
+		if substr, ok := p["substr"].(string); ok {
+			return map[string]any{"pattern": regexp.QuoteMeta(substr)}
+		}
+	case "Excludes":
+		if substr, ok := p["substr"].(string); ok {
+			return map[string]any{"not": map[string]any{"pattern": regexp.QuoteMeta(substr)}}
+		}
+	case "NoWhitespace":
+		return map[string]any{"pattern": `^\S*$`}
+	case "SingleLine":
+		return map[string]any{"pattern": `^[^\n\r\v\f\x{85}\x{2028}\x{2029}]*$`}
+	case "And":
+		schema := map[string]any{}
+		for _, child := range children() {
+			schema = mergeSchemas(schema, child.(map[string]any))
+		}
+		return schema
+	case "Or":
+		return map[string]any{"anyOf": children()}
+	case "OneOfValidators":
+		return map[string]any{"oneOf": children()}
+	case "Not":
+		if len(rule.Children) == 1 {
+			return map[string]any{"not": ruleSchema(rule.Children[0], jsonType)}
+		}
+	case "WithMessage", "WithCode", "WithErrorf", "OmitEmpty", "Deref":
+		if len(rule.Children) == 1 {
+			return ruleSchema(rule.Children[0], jsonType)
+		}
+	case "Each":
+		if len(rule.Children) == 1 {
+			itemType, _ := p["type"].(string)
+			return map[string]any{"items": typedSchema(rule.Children[0], itemType)}
+		}
+	case "Struct":
+		properties := map[string]any{}
+		required := []string{}
+		for _, field := range rule.Children {
+			// the fields without a name or a validator can't be described
+			name, ok := field.Params["name"].(string)
+			if !ok || len(field.Children) == 0 {
+				continue
+			}
+			fieldType, _ := field.Params["type"].(string)
+			properties[name] = typedSchema(field.Children[0], fieldType)
+			if field.Params["required"] == true {
+				required = append(required, name)
+			}
+		}
+		schema := map[string]any{"type": "object", "properties": properties}
+		if len(required) > 0 {
+			schema["required"] = required
+		}
+		if additional, ok := p["additionalProperties"]; ok {
+			schema["additionalProperties"] = additional
+		}
+		return schema
+	case "UniqueItems":
+		return map[string]any{"uniqueItems": true}
+	case "URLWith":
+		schema := map[string]any{"format": "uri"}
+		if len(p) > 0 {
+			schema["x-URLWith"] = p
+		}
+		return schema
+	}
+	if keyword, ok := ruleKeywords[rule.Name]; ok {
+		// the rules have a single parameter
+		for _, v := range p {
+			return map[string]any{keyword: v}
+		}
+	}
+	if format, ok := ruleFormats[rule.Name]; ok {
+		return map[string]any{"format": format}
+	}
+	// the rules without JSON Schema equivalent
+	var extension any = true
+	if len(p) > 0 {
+		extension = p
+	}
+	if len(rule.Children) > 0 {
+		ext := map[string]any{"schemas": children()}
+		for k, v := range p {
+			ext[k] = v
+		}
+		extension = ext
+	}
+	return map[string]any{"x-" + rule.Name: extension}
+}
+
+// Merges the keywords of the schemas, and moves the schema to an allOf keyword if they conflict.
+func mergeSchemas(schema map[string]any, other map[string]any) map[string]any {
+	for k, v := range other {
+		if w, ok := schema[k]; ok && !reflect.DeepEqual(v, w) {
+			allOf, _ := schema["allOf"].([]any)
+			schema["allOf"] = append(allOf, other)
+			return schema
+		}
+	}
+	for k, v := range other {
+		schema[k] = v
+	}
+	return schema
+}
+
+func isNumber(v any) bool {
+	switch reflect.ValueOf(v).Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,706 @@
+// Validators compiled from JSON Schema (draft 2020-12) documents, e.g. for the payloads of partners
+// that publish their own schemas:
+//
+//	validatePayload, err := fv.CompileJSONSchema(schemaJSON)
+//	validatePayload(json.RawMessage(`{"email": "x", "tags": ["a", 1]}`))
+//	// -> "error: JSONSchema (/email: error: Regexp, /tags/1: error: Type)"
+//
+// The supported keywords are type, const, enum, minimum, maximum, exclusiveMinimum, exclusiveMaximum,
+// multipleOf, minLength, maxLength, pattern, format, items, minItems, maxItems, uniqueItems,
+// properties, required, additionalProperties, minProperties, maxProperties, allOf, anyOf, oneOf, not
+// and $ref (to the same document). The formats are checked by the built-in validators (email, uuid,
+// ipv4, ipv6, uri, hostname and json-pointer), the unknown formats and keywords are ignored as
+// annotations. The compiled validators report the rules of the schema (see RuleOf), so they can be
+// described or exported again.
+package funcvalid
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+// PointerError is the error of a value of a JSON document located by a JSON Pointer (RFC 6901),
+// e.g. "/tags/1". The pointer of the document itself is the empty string.
+type PointerError struct {
+	Pointer string
+	Err     error
+}
+
+func (e *PointerError) Error() string {
+	if e.Pointer == "" {
+		return e.Err.Error()
+	}
+	return e.Pointer + ": " + e.Err.Error()
+}
+
+func (e *PointerError) Unwrap() error {
+	return e.Err
+}
+
+// The validators of the formats of JSON Schema.
+var jsonSchemaFormats = map[string]Validator[string]{
+	"email":        Email,
+	"uuid":         UUID,
+	"ipv4":         Ipv4,
+	"ipv6":         Ipv6,
+	"uri":          Url,
+	"hostname":     HostnameRFC1123,
+	"json-pointer": JSONPointer,
+}
+
+// Compiles the JSON Schema document to a validator of JSON values. The input of the validator can be
+// a JSON document as json.RawMessage or []byte, a value decoded by json.Unmarshal into an any (e.g. a
+// map[string]any), or any other value that is validated by its JSON encoding. The error of the
+// validator is a RuleError with code "JSONSchema" that lists the PointerErrors of the invalid values.
+// (The errors of the alternatives of anyOf, oneOf and not are located relative to their value.)
+func CompileJSONSchema(schema []byte) (Validator[any], error) {
+	var root any
+	if err := json.Unmarshal(schema, &root); err != nil {
+		return nil, fmt.Errorf("error: json schema: %w", err)
+	}
+	c := &schemaCompiler{root: root, refs: map[string]*Validator[any]{}, inPlaceRefs: map[string][]string{}, inPlace: true}
+	validator, err := c.compile(root, "")
+	if err != nil {
+		return nil, err
+	}
+	if err := c.checkRefCycles(); err != nil {
+		return nil, err
+	}
+	return withRule(RuleOf(validator), func(inp any) error {
+		value, err := jsonValue(inp)
+		if err != nil {
+			return &RuleError{Code: "JSONSchema", Errs: []error{&PointerError{Err: ruleError("JSON")}}}
+		}
+		if err := validator(value); err != nil {
+			return &RuleError{Code: "JSONSchema", Errs: pointerErrors("", err)}
+		}
+		return nil
+	}), nil
+}
+
+type schemaCompiler struct {
+	root any
+	refs map[string]*Validator[any] // the validators of the referenced schemas by their pointers
+	// the references of the referenced schemas (by their pointers) that are applied to the same value
+	// (not to a nested one), to find the cycles that would never end
+	inPlaceRefs map[string][]string
+	place       string // the pointer of the referenced schema being compiled
+	inPlace     bool   // if the schema being compiled is applied to the same value as the schema at place
+
+}
+
+// Compiles the schema at the pointer of the document to a validator of decoded JSON values.
+func (c *schemaCompiler) compile(schema any, pointer string) (Validator[any], error) {
+	switch s := schema.(type) {
+	case bool:
+		if s {
+			return And[any](), nil
+		}
+		return Not(And[any]()), nil
+	case map[string]any:
+		return c.compileObject(s, pointer)
+	}
+	return nil, fmt.Errorf("error: json schema %q: schema must be an object or a boolean", pointer)
+}
+
+// Compiles the schema of the nested values (e.g. of the properties), whose references can't be cyclic.
+func (c *schemaCompiler) compileNested(schema any, pointer string) (Validator[any], error) {
+	inPlace := c.inPlace
+	c.inPlace = false
+	defer func() { c.inPlace = inPlace }()
+	return c.compile(schema, pointer)
+}
+
+// Returns an error if a chain of references applied to the same value leads back to its start, that
+// would validate forever.
+func (c *schemaCompiler) checkRefCycles() error {
+	const (
+		visiting = 1
+		visited  = 2
+	)
+	state := map[string]int{}
+	var visit func(pointer string) error
+	visit = func(pointer string) error {
+		switch state[pointer] {
+		case visiting:
+			return fmt.Errorf("error: json schema %q: cyclic $ref", pointer)
+		case visited:
+			return nil
+		}
+		state[pointer] = visiting
+		for _, next := range c.inPlaceRefs[pointer] {
+			if err := visit(next); err != nil {
+				return err
+			}
+		}
+		state[pointer] = visited
+		return nil
+	}
+	pointers := make([]string, 0, len(c.inPlaceRefs))
+	for pointer := range c.inPlaceRefs {
+		pointers = append(pointers, pointer)
+	}
+	sort.Strings(pointers)
+	for _, pointer := range pointers {
+		if err := visit(pointer); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *schemaCompiler) compileObject(s map[string]any, pointer string) (Validator[any], error) {
+	invalid := func(keyword string) error {
+		return fmt.Errorf("error: json schema %q: invalid %s", pointer, keyword)
+	}
+	var validators []Validator[any]
+	if ref, ok := s["$ref"]; ok {
+		v, err := c.compileRef(ref, pointer)
+		if err != nil {
+			return nil, err
+		}
+		validators = append(validators, v)
+	}
+	if t, ok := s["type"]; ok {
+		types, ok := stringList(t)
+		if !ok {
+			return nil, invalid("type")
+		}
+		validators = append(validators, typeValidator(t, types))
+	}
+	if value, ok := s["const"]; ok {
+		validators = append(validators, withRule(newRule("Eq", "value", value), func(inp any) error {
+			if !jsonEqual(inp, value) {
+				return ruleError("Eq", "value", value)
+			}
+			return nil
+		}))
+	}
+	if e, ok := s["enum"]; ok {
+		values, ok := e.([]any)
+		if !ok {
+			return nil, invalid("enum")
+		}
+		validators = append(validators, withRule(newRule("OneOf", "values", values), func(inp any) error {
+			for _, value := range values {
+				if jsonEqual(inp, value) {
+					return nil
+				}
+			}
+			return ruleError("OneOf", "values", values)
+		}))
+	}
+	numberKeywords := []struct {
+		keyword string
+		code    string
+		valid   func(n float64, limit float64) bool
+	}{
+		{"minimum", "Minimum", func(n, limit float64) bool { return n >= limit }},
+		{"maximum", "Maximum", func(n, limit float64) bool { return n <= limit }},
+		{"exclusiveMinimum", "Gt", func(n, limit float64) bool { return n > limit }},
+		{"exclusiveMaximum", "Lt", func(n, limit float64) bool { return n < limit }},
+		{"multipleOf", "MultipleOf", func(n, limit float64) bool {
+			q := n / limit
+			return math.Abs(q-math.Round(q)) < 1e-9
+		}},
+	}
+	for _, k := range numberKeywords {
+		if value, ok := s[k.keyword]; ok {
+			limit, ok := jsonNumber(value)
+			if !ok || (k.keyword == "multipleOf" && limit <= 0) {
+				return nil, invalid(k.keyword)
+			}
+			validators = append(validators, numberValidator(k.code, value, limit, k.valid))
+		}
+	}
+	countKeywords := []struct {
+		keyword string
+		code    string
+		count   func(inp any) (int, bool)
+		min     bool
+	}{
+		{"minLength", "MinLength", stringLength, true},
+		{"maxLength", "MaxLength", stringLength, false},
+		{"minItems", "MinItems", arrayLength, true},
+		{"maxItems", "MaxItems", arrayLength, false},
+		{"minProperties", "MinProperties", objectLength, true},
+		{"maxProperties", "MaxProperties", objectLength, false},
+	}
+	for _, k := range countKeywords {
+		if value, ok := s[k.keyword]; ok {
+			limit, ok := jsonNumber(value)
+			if !ok || limit < 0 || limit != math.Trunc(limit) {
+				return nil, invalid(k.keyword)
+			}
+			validators = append(validators, countValidator(k.code, int(limit), k.count, k.min))
+		}
+	}
+	if p, ok := s["pattern"]; ok {
+		pattern, ok := p.(string)
+		if !ok {
+			return nil, invalid("pattern")
+		}
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("error: json schema %q: invalid pattern: %w", pointer, err)
+		}
+		validators = append(validators, stringValidator(RegexpRE(re)))
+	}
+	if f, ok := s["format"].(string); ok {
+		if v, ok := jsonSchemaFormats[f]; ok {
+			validators = append(validators, stringValidator(v))
+		}
+	}
+	if items, ok := s["items"]; ok {
+		v, err := c.compileNested(items, pointer+"/items")
+		if err != nil {
+			return nil, err
+		}
+		validators = append(validators, itemsValidator(v))
+	}
+	if s["uniqueItems"] == true {
+		validators = append(validators, withRule(newRule("UniqueItems"), func(inp any) error {
+			if arr, ok := inp.([]any); ok {
+				for i := range arr {
+					for j := i + 1; j < len(arr); j++ {
+						if jsonEqual(arr[i], arr[j]) {
+							return ruleError("UniqueItems", IndexParam, j)
+						}
+					}
+				}
+			}
+			return nil
+		}))
+	}
+	_, hasProperties := s["properties"]
+	_, hasRequired := s["required"]
+	_, hasAdditional := s["additionalProperties"]
+	if hasProperties || hasRequired || hasAdditional {
+		v, err := c.compileProperties(s, pointer)
+		if err != nil {
+			return nil, err
+		}
+		validators = append(validators, v)
+	}
+	for _, combinator := range []string{"allOf", "anyOf", "oneOf"} {
+		subschemas, ok := s[combinator]
+		if !ok {
+			continue
+		}
+		list, ok := subschemas.([]any)
+		if !ok || len(list) == 0 {
+			return nil, invalid(combinator)
+		}
+		alternatives := make([]Validator[any], len(list))
+		for i, subschema := range list {
+			v, err := c.compile(subschema, fmt.Sprintf("%s/%s/%d", pointer, combinator, i))
+			if err != nil {
+				return nil, err
+			}
+			alternatives[i] = v
+		}
+		switch combinator {
+		case "allOf":
+			validators = append(validators, And(alternatives...))
+		case "anyOf":
+			validators = append(validators, Or(alternatives...))
+		case "oneOf":
+			validators = append(validators, OneOfValidators(alternatives...))
+		}
+	}
+	if not, ok := s["not"]; ok {
+		v, err := c.compile(not, pointer+"/not")
+		if err != nil {
+			return nil, err
+		}
+		validators = append(validators, Not(v))
+	}
+	if len(validators) == 1 {
+		return validators[0], nil
+	}
+	return And(validators...), nil
+}
+
+// Compiles the properties, required and additionalProperties keywords to a validator that reports the
+// errors of all the invalid properties (like Struct).
+func (c *schemaCompiler) compileProperties(s map[string]any, pointer string) (Validator[any], error) {
+	properties, ok := s["properties"].(map[string]any)
+	if _, exists := s["properties"]; exists && !ok {
+		return nil, fmt.Errorf("error: json schema %q: invalid properties", pointer)
+	}
+	required, ok := stringList(s["required"])
+	if _, exists := s["required"]; exists && !ok {
+		return nil, fmt.Errorf("error: json schema %q: invalid required", pointer)
+	}
+	names := make([]string, 0, len(properties))
+	for name := range properties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range required {
+		if _, ok := properties[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	validators := make(map[string]Validator[any], len(names))
+	rule := Rule{Name: "Struct"}
+	for _, name := range names {
+		v := And[any]()
+		if subschema, ok := properties[name]; ok {
+			var err error
+			if v, err = c.compileNested(subschema, pointer+"/properties/"+escapePointerToken(name)); err != nil {
+				return nil, err
+			}
+		}
+		validators[name] = v
+		subschema, _ := properties[name].(map[string]any)
+		t, _ := subschema["type"].(string)
+		rule.Children = append(rule.Children, Rule{
+			Name:     "Field",
+			Params:   map[string]any{"name": name, "required": slices.Contains(required, name), "type": t},
+			Children: []Rule{RuleOf(v)},
+		})
+	}
+	var additional Validator[any]
+	if a, ok := s["additionalProperties"]; ok {
+		if a == false {
+			additional = withRule(Rule{Name: "Not", Children: []Rule{{Name: "And"}}}, func(inp any) error {
+				return ruleError("AdditionalProperties")
+			})
+		} else {
+			var err error
+			if additional, err = c.compileNested(a, pointer+"/additionalProperties"); err != nil {
+				return nil, err
+			}
+		}
+		rule.Params = map[string]any{"additionalProperties": a}
+	}
+	return withRule(rule, func(inp any) error {
+		obj, ok := inp.(map[string]any)
+		if !ok {
+			return nil
+		}
+		var errs []error
+		for _, name := range required {
+			if _, ok := obj[name]; !ok {
+				errs = append(errs, &PointerError{Pointer: "/" + escapePointerToken(name), Err: ruleError("Required")})
+			}
+		}
+		keys := make([]string, 0, len(obj))
+		for key := range obj {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			v, ok := validators[key]
+			if !ok {
+				v = additional
+			}
+			if v == nil {
+				continue
+			}
+			if err := v(obj[key]); err != nil {
+				errs = append(errs, pointerErrors("/"+escapePointerToken(key), err)...)
+			}
+		}
+		if len(errs) > 0 {
+			return &RuleError{Code: "JSONSchema", Errs: errs}
+		}
+		return nil
+	}), nil
+}
+
+// Compiles the reference to a validator that looks up the validator of the referenced schema when
+// it's called, so the schemas can be recursive.
+func (c *schemaCompiler) compileRef(r any, pointer string) (Validator[any], error) {
+	ref, ok := r.(string)
+	if !ok || !strings.HasPrefix(ref, "#") {
+		return nil, fmt.Errorf("error: json schema %q: unsupported $ref %v", pointer, r)
+	}
+	target, ok := resolvePointer(c.root, ref[1:])
+	if !ok {
+		return nil, fmt.Errorf("error: json schema %q: unresolved $ref %q", pointer, ref)
+	}
+	if c.inPlace {
+		c.inPlaceRefs[c.place] = append(c.inPlaceRefs[c.place], ref[1:])
+	}
+	v, ok := c.refs[ref]
+	if !ok {
+		v = new(Validator[any])
+		c.refs[ref] = v
+		place, inPlace := c.place, c.inPlace
+		c.place, c.inPlace = ref[1:], true
+		compiled, err := c.compile(target, ref[1:])
+		c.place, c.inPlace = place, inPlace
+		if err != nil {
+			return nil, err
+		}
+		*v = compiled
+	}
+	return withRule(newRule("Ref", "ref", ref), func(inp any) error {
+		return (*v)(inp)
+	}), nil
+}
+
+func typeValidator(t any, types []string) Validator[any] {
+	return withRule(newRule("Type", "type", t), func(inp any) error {
+		actual := jsonTypeOfValue(inp)
+		for _, expected := range types {
+			if expected == actual || (expected == "number" && actual == "integer") {
+				return nil
+			}
+		}
+		return ruleError("Type", "type", t)
+	})
+}
+
+func numberValidator(code string, value any, limit float64, valid func(n float64, limit float64) bool) Validator[any] {
+	return withRule(newRule(code, "value", value), func(inp any) error {
+		if n, ok := jsonNumber(inp); ok && !valid(n, limit) {
+			return ruleError(code, "value", value)
+		}
+		return nil
+	})
+}
+
+func countValidator(code string, limit int, count func(inp any) (int, bool), min bool) Validator[any] {
+	return withRule(newRule(code, "length", limit), func(inp any) error {
+		if n, ok := count(inp); ok && ((min && n < limit) || (!min && n > limit)) {
+			return ruleError(code, "length", limit)
+		}
+		return nil
+	})
+}
+
+// Returns a validator of JSON values that validates the strings with the string validator, and
+// accepts the other values.
+func stringValidator(validator Validator[string]) Validator[any] {
+	return withRule(RuleOf(validator), func(inp any) error {
+		if s, ok := inp.(string); ok {
+			return validator(s)
+		}
+		return nil
+	})
+}
+
+func itemsValidator(validator Validator[any]) Validator[any] {
+	return withRule(Rule{Name: "Each", Children: []Rule{RuleOf(validator)}}, func(inp any) error {
+		arr, ok := inp.([]any)
+		if !ok {
+			return nil
+		}
+		var errs []error
+		for i, item := range arr {
+			if err := validator(item); err != nil {
+				errs = append(errs, pointerErrors(fmt.Sprintf("/%d", i), err)...)
+			}
+		}
+		if len(errs) > 0 {
+			return &RuleError{Code: "JSONSchema", Errs: errs}
+		}
+		return nil
+	})
+}
+
+// Returns the errors of the value at the pointer: the PointerErrors of the nested values prefixed
+// with the pointer, or the error itself.
+func pointerErrors(pointer string, err error) []error {
+	e, ok := err.(*RuleError)
+	if !ok || e.Code != "JSONSchema" {
+		return []error{&PointerError{Pointer: pointer, Err: err}}
+	}
+	errs := make([]error, len(e.Errs))
+	for i, nested := range e.Errs {
+		if pe, ok := nested.(*PointerError); ok {
+			errs[i] = &PointerError{Pointer: pointer + pe.Pointer, Err: pe.Err}
+		} else {
+			errs[i] = &PointerError{Pointer: pointer, Err: nested}
+		}
+	}
+	return errs
+}
+
+func escapePointerToken(token string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(token, "~", "~0"), "/", "~1")
+}
+
+// Returns the value at the JSON Pointer in the document.
+func resolvePointer(doc any, pointer string) (any, bool) {
+	if pointer == "" {
+		return doc, true
+	}
+	if pointer[0] != '/' {
+		return nil, false
+	}
+	for _, token := range strings.Split(pointer[1:], "/") {
+		token = strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")
+		switch d := doc.(type) {
+		case map[string]any:
+			value, ok := d[token]
+			if !ok {
+				return nil, false
+			}
+			doc = value
+		case []any:
+			var i int
+			if _, err := fmt.Sscan(token, &i); err != nil || i < 0 || i >= len(d) {
+				return nil, false
+			}
+			doc = d[i]
+		default:
+			return nil, false
+		}
+	}
+	return doc, true
+}
+
+// Returns the input as a decoded JSON value (as decoded by json.Unmarshal into an any).
+func jsonValue(inp any) (any, error) {
+	var data []byte
+	switch v := inp.(type) {
+	case json.RawMessage:
+		data = v
+	case []byte:
+		data = v
+	default:
+		if isDecodedJSON(inp) {
+			return inp, nil
+		}
+		var err error
+		if data, err = json.Marshal(inp); err != nil {
+			return nil, err
+		}
+	}
+	var value any
+	err := json.Unmarshal(data, &value)
+	return value, err
+}
+
+func isDecodedJSON(v any) bool {
+	switch v := v.(type) {
+	case nil, bool, float64, string, json.Number:
+		return true
+	case []any:
+		for _, item := range v {
+			if !isDecodedJSON(item) {
+				return false
+			}
+		}
+		return true
+	case map[string]any:
+		for _, item := range v {
+			if !isDecodedJSON(item) {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
+
+// Returns the JSON type of the decoded JSON value, e.g. "integer" for 42.0.
+func jsonTypeOfValue(v any) string {
+	switch v.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "boolean"
+	case string:
+		return "string"
+	case []any:
+		return "array"
+	case map[string]any:
+		return "object"
+	}
+	if n, ok := jsonNumber(v); ok {
+		if n == math.Trunc(n) {
+			return "integer"
+		}
+		return "number"
+	}
+	return ""
+}
+
+func jsonNumber(v any) (float64, bool) {
+	switch v := v.(type) {
+	case float64:
+		return v, true
+	case json.Number:
+		n, err := v.Float64()
+		return n, err == nil
+	}
+	return 0, false
+}
+
+// Returns if the decoded JSON values are equal (the numbers are compared by their values).
+func jsonEqual(a any, b any) bool {
+	if x, ok := jsonNumber(a); ok {
+		y, ok := jsonNumber(b)
+		return ok && x == y
+	}
+	switch a := a.(type) {
+	case []any:
+		b, ok := b.([]any)
+		if !ok || len(a) != len(b) {
+			return false
+		}
+		for i := range a {
+			if !jsonEqual(a[i], b[i]) {
+				return false
+			}
+		}
+		return true
+	case map[string]any:
+		b, ok := b.(map[string]any)
+		if !ok || len(a) != len(b) {
+			return false
+		}
+		for k, v := range a {
+			if w, ok := b[k]; !ok || !jsonEqual(v, w) {
+				return false
+			}
+		}
+		return true
+	}
+	return a == b
+}
+
+// Returns the elements of a string or a list of strings.
+func stringList(v any) ([]string, bool) {
+	switch v := v.(type) {
+	case string:
+		return []string{v}, true
+	case []any:
+		list := make([]string, len(v))
+		for i, item := range v {
+			s, ok := item.(string)
+			if !ok {
+				return nil, false
+			}
+			list[i] = s
+		}
+		return list, true
+	}
+	return nil, false
+}
+
+func stringLength(inp any) (int, bool) {
+	s, ok := inp.(string)
+	return utf8.RuneCountInString(s), ok
+}
+
+func arrayLength(inp any) (int, bool) {
+	arr, ok := inp.([]any)
+	return len(arr), ok
+}
+
+func objectLength(inp any) (int, bool) {
+	obj, ok := inp.(map[string]any)
+	return len(obj), ok
+}
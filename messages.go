@@ -0,0 +1,428 @@
+// The bundled message catalogs and plural rules of the Translator.
+package funcvalid
+
+func pluralOne(n float64) string {
+	if n == 1 {
+		return "one"
+	}
+	return "other"
+}
+
+var bundledPluralRules = map[string]PluralRule{
+	"en": pluralOne,
+	"de": pluralOne,
+	"hu": pluralOne,
+	"fr": func(n float64) string {
+		if n >= 0 && n < 2 {
+			return "one"
+		}
+		return "other"
+	},
+}
+
+var bundledCatalogs = map[string]Catalog{
+	"en": {
+		"Eq":                   "must be {value}",
+		"Lt":                   "must be less than {value}",
+		"Gt":                   "must be greater than {value}",
+		"Regexp":               "has an invalid format",
+		"LenEq":                "must have a length of {length}",
+		"LenBw":                "must have a length between {min} and {max}",
+		"LenLt":                "must have a length less than {length}",
+		"LenGt":                "must have a length greater than {length}",
+		"OneOf":                "must be one of {values}",
+		"IsKeyIn":              "is not an allowed value",
+		"IsValueIn":            "is not an allowed value",
+		"Not":                  "is not allowed",
+		"Or":                   "doesn't satisfy any of the alternatives",
+		"OneOfValidators":      "must satisfy exactly one of the alternatives",
+		"AtLeast":              "must satisfy at least {count} of the alternatives",
+		"AtMost":               "must satisfy at most {count} of the alternatives",
+		"HasPrefix":            "must start with {prefix}",
+		"HasSuffix":            "must end with {suffix}",
+		"Contains":             "must contain {substr}",
+		"ContainsAny":          "must contain any of the characters {chars}",
+		"Excludes":             "must not contain {substr}",
+		"Lowercase":            "must be lowercase",
+		"Uppercase":            "must be uppercase",
+		"Trimmed":              "must not start or end with whitespace",
+		"NoWhitespace":         "must not contain whitespace",
+		"SingleLine":           "must be a single line",
+		"EqFold":               "must be {value}",
+		"OneOfFold":            "must be one of {values}",
+		"GraphemeLenBw":        "must be between {min} and {max, plural, one {# character} other {# characters}} long",
+		"Type":                 "must be of type {type}",
+		"Minimum":              "must be at least {value}",
+		"Maximum":              "must be at most {value}",
+		"MultipleOf":           "must be a multiple of {value}",
+		"MinLength":            "must be at least {length, plural, one {# character} other {# characters}} long",
+		"MaxLength":            "must be at most {length, plural, one {# character} other {# characters}} long",
+		"MinItems":             "must have at least {length, plural, one {# item} other {# items}}",
+		"MaxItems":             "must have at most {length, plural, one {# item} other {# items}}",
+		"MinProperties":        "must have at least {length, plural, one {# property} other {# properties}}",
+		"MaxProperties":        "must have at most {length, plural, one {# property} other {# properties}}",
+		"UniqueItems":          "must not contain duplicate items",
+		"Required":             "is required",
+		"AdditionalProperties": "is not an allowed property",
+		"Int":                  "must be an integer",
+		"Float":                "must be a number",
+		"Bool":                 "must be true or false",
+		"Time":                 "must be a time in the format {layout}",
+		"Duplicate":            "must not be repeated",
+		"PhoneNumber":          "must be a valid phone number",
+		"PhoneNumberOfType":    "must be a phone number of type {types}",
+		"URLWith":              "must be an allowed URL",
+		"JWTWith":              "must be a valid token",
+		"Cron":                 "must be a valid cron expression",
+		"PasswordPolicy":       "doesn't satisfy the password policy",
+		"UUID":                 "must be a UUID",
+		"UUIDVersion":          "must be a UUID of version {versions}",
+		"UUIDVariantRFC9562":   "must be an RFC 9562 UUID",
+		"UUIDv7After":          "must be a version 7 UUID created after {time}",
+		"UUIDv7Before":         "must be a version 7 UUID created before {time}",
+		"ULID":                 "must be a ULID",
+		"ULIDAfter":            "must be a ULID created after {time}",
+		"ULIDBefore":           "must be a ULID created before {time}",
+		"NFC":                  "must be in Unicode normalization form C",
+		"NFKC":                 "must be in Unicode normalization form KC",
+		"Script":               "must only contain characters of the scripts {scripts}",
+		"NoMixedScripts":       "must not mix the characters of different scripts",
+		"NotConfusable":        "is too similar to an existing value",
+		"NoControlChars":       "must not contain control characters",
+		"NoBidiOverride":       "must not contain bidirectional control characters",
+		"Dir":                  "must be an existing directory",
+		"FileExists":           "must be an existing file",
+		"Readable":             "must be readable",
+		"Executable":           "must be executable",
+		"MaxFileSize":          "must be a file of at most {size, plural, one {# byte} other {# bytes}}",
+		"FileExtension":        "must have one of the extensions {extensions}",
+		"MIMEType":             "must be a file of type {types}",
+		"PathWithin":           "must be a path within {root}",
+		"JSON":                 "must be valid JSON",
+		"JSONObject":           "must be a JSON object",
+		"JSONArray":            "must be a JSON array",
+		"JSONPointer":          "must be a JSON pointer",
+		"JSONMatches":          "must be valid JSON",
+		"Digest":               "must be a valid digest",
+		"DigestOf":             "must be the digest of the content",
+		"DigestMatches":        "must be the digest of the content",
+		"EmailWith":            "must be an allowed email address",
+		"MIME":                 "must be content of type {types}",
+		"Base64Decoded":        "must be valid base64",
+		"Base64URLDecoded":     "must be valid base64",
+		"Base64RawDecoded":     "must be valid base64",
+		"Base64RawURLDecoded":  "must be valid base64",
+		"HexDecoded":           "must be valid hexadecimal",
+		"Iso3166":              "must be a country code",
+		"Iso3166Alpha2":        "must be an ISO 3166-1 alpha-2 country code",
+		"Iso3166Alpha3":        "must be an ISO 3166-1 alpha-3 country code",
+		"Iso3166AlphaNumeric":  "must be an ISO 3166-1 numeric country code",
+		"Iso3166_2":            "must be an ISO 3166-2 subdivision code",
+		"Iso4217":              "must be an ISO 4217 currency code",
+		"Iso4217Numeric":       "must be an ISO 4217 numeric currency code",
+		"PostCodeByIso3166":    "must be a valid postcode of {country}",
+	},
+	"de": {
+		"Eq":                   "muss {value} sein",
+		"Lt":                   "muss kleiner als {value} sein",
+		"Gt":                   "muss größer als {value} sein",
+		"Regexp":               "hat ein ungültiges Format",
+		"LenEq":                "muss die Länge {length} haben",
+		"LenBw":                "muss eine Länge zwischen {min} und {max} haben",
+		"LenLt":                "muss eine Länge kleiner als {length} haben",
+		"LenGt":                "muss eine Länge größer als {length} haben",
+		"OneOf":                "muss einer der Werte {values} sein",
+		"IsKeyIn":              "ist kein zulässiger Wert",
+		"IsValueIn":            "ist kein zulässiger Wert",
+		"Not":                  "ist nicht zulässig",
+		"Or":                   "erfüllt keine der Alternativen",
+		"OneOfValidators":      "muss genau eine der Alternativen erfüllen",
+		"AtLeast":              "muss mindestens {count} der Alternativen erfüllen",
+		"AtMost":               "darf höchstens {count} der Alternativen erfüllen",
+		"HasPrefix":            "muss mit {prefix} beginnen",
+		"HasSuffix":            "muss mit {suffix} enden",
+		"Contains":             "muss {substr} enthalten",
+		"ContainsAny":          "muss eines der Zeichen {chars} enthalten",
+		"Excludes":             "darf {substr} nicht enthalten",
+		"Lowercase":            "darf nur Kleinbuchstaben enthalten",
+		"Uppercase":            "darf nur Großbuchstaben enthalten",
+		"Trimmed":              "darf nicht mit Leerzeichen beginnen oder enden",
+		"NoWhitespace":         "darf keine Leerzeichen enthalten",
+		"SingleLine":           "muss einzeilig sein",
+		"EqFold":               "muss {value} sein",
+		"OneOfFold":            "muss einer der Werte {values} sein",
+		"GraphemeLenBw":        "muss zwischen {min} und {max} Zeichen lang sein",
+		"Type":                 "muss vom Typ {type} sein",
+		"Minimum":              "muss mindestens {value} sein",
+		"Maximum":              "darf höchstens {value} sein",
+		"MultipleOf":           "muss ein Vielfaches von {value} sein",
+		"MinLength":            "muss mindestens {length} Zeichen lang sein",
+		"MaxLength":            "darf höchstens {length} Zeichen lang sein",
+		"MinItems":             "muss mindestens {length, plural, one {# Element} other {# Elemente}} haben",
+		"MaxItems":             "darf höchstens {length, plural, one {# Element} other {# Elemente}} haben",
+		"MinProperties":        "muss mindestens {length, plural, one {# Eigenschaft} other {# Eigenschaften}} haben",
+		"MaxProperties":        "darf höchstens {length, plural, one {# Eigenschaft} other {# Eigenschaften}} haben",
+		"UniqueItems":          "darf keine doppelten Elemente enthalten",
+		"Required":             "ist erforderlich",
+		"AdditionalProperties": "ist keine zulässige Eigenschaft",
+		"Int":                  "muss eine ganze Zahl sein",
+		"Float":                "muss eine Zahl sein",
+		"Bool":                 "muss true oder false sein",
+		"Time":                 "muss eine Zeitangabe im Format {layout} sein",
+		"Duplicate":            "darf nicht wiederholt werden",
+		"PhoneNumber":          "muss eine gültige Telefonnummer sein",
+		"PhoneNumberOfType":    "muss eine Telefonnummer vom Typ {types} sein",
+		"URLWith":              "muss eine zulässige URL sein",
+		"JWTWith":              "muss ein gültiges Token sein",
+		"Cron":                 "muss ein gültiger Cron-Ausdruck sein",
+		"PasswordPolicy":       "erfüllt die Passwortrichtlinie nicht",
+		"UUID":                 "muss eine UUID sein",
+		"UUIDVersion":          "muss eine UUID der Version {versions} sein",
+		"UUIDVariantRFC9562":   "muss eine UUID nach RFC 9562 sein",
+		"UUIDv7After":          "muss eine nach {time} erzeugte UUID der Version 7 sein",
+		"UUIDv7Before":         "muss eine vor {time} erzeugte UUID der Version 7 sein",
+		"ULID":                 "muss eine ULID sein",
+		"ULIDAfter":            "muss eine nach {time} erzeugte ULID sein",
+		"ULIDBefore":           "muss eine vor {time} erzeugte ULID sein",
+		"NFC":                  "muss in der Unicode-Normalform C sein",
+		"NFKC":                 "muss in der Unicode-Normalform KC sein",
+		"Script":               "darf nur Zeichen der Schriften {scripts} enthalten",
+		"NoMixedScripts":       "darf keine Zeichen verschiedener Schriften mischen",
+		"NotConfusable":        "ist einem vorhandenen Wert zu ähnlich",
+		"NoControlChars":       "darf keine Steuerzeichen enthalten",
+		"NoBidiOverride":       "darf keine bidirektionalen Steuerzeichen enthalten",
+		"Dir":                  "muss ein vorhandenes Verzeichnis sein",
+		"FileExists":           "muss eine vorhandene Datei sein",
+		"Readable":             "muss lesbar sein",
+		"Executable":           "muss ausführbar sein",
+		"MaxFileSize":          "muss eine Datei von höchstens {size, plural, one {# Byte} other {# Bytes}} sein",
+		"FileExtension":        "muss eine der Endungen {extensions} haben",
+		"MIMEType":             "muss eine Datei vom Typ {types} sein",
+		"PathWithin":           "muss ein Pfad innerhalb von {root} sein",
+		"JSON":                 "muss gültiges JSON sein",
+		"JSONObject":           "muss ein JSON-Objekt sein",
+		"JSONArray":            "muss ein JSON-Array sein",
+		"JSONPointer":          "muss ein JSON-Pointer sein",
+		"JSONMatches":          "muss gültiges JSON sein",
+		"Digest":               "muss ein gültiger Hashwert sein",
+		"DigestOf":             "muss der Hashwert des Inhalts sein",
+		"DigestMatches":        "muss der Hashwert des Inhalts sein",
+		"EmailWith":            "muss eine zulässige E-Mail-Adresse sein",
+		"MIME":                 "muss Inhalt vom Typ {types} sein",
+		"Base64Decoded":        "muss gültiges Base64 sein",
+		"Base64URLDecoded":     "muss gültiges Base64 sein",
+		"Base64RawDecoded":     "muss gültiges Base64 sein",
+		"Base64RawURLDecoded":  "muss gültiges Base64 sein",
+		"HexDecoded":           "muss gültig hexadezimal kodiert sein",
+		"Iso3166":              "muss ein Ländercode sein",
+		"Iso3166Alpha2":        "muss ein ISO 3166-1 Alpha-2-Ländercode sein",
+		"Iso3166Alpha3":        "muss ein ISO 3166-1 Alpha-3-Ländercode sein",
+		"Iso3166AlphaNumeric":  "muss ein numerischer ISO 3166-1 Ländercode sein",
+		"Iso3166_2":            "muss ein ISO 3166-2 Regionscode sein",
+		"Iso4217":              "muss ein ISO 4217 Währungscode sein",
+		"Iso4217Numeric":       "muss ein numerischer ISO 4217 Währungscode sein",
+		"PostCodeByIso3166":    "muss eine gültige Postleitzahl von {country} sein",
+	},
+	"hu": {
+		"Eq":                   "értéke {value} kell legyen",
+		"Lt":                   "kisebb kell legyen, mint {value}",
+		"Gt":                   "nagyobb kell legyen, mint {value}",
+		"Regexp":               "formátuma érvénytelen",
+		"LenEq":                "hossza {length} kell legyen",
+		"LenBw":                "hossza {min} és {max} között kell legyen",
+		"LenLt":                "hossza kisebb kell legyen, mint {length}",
+		"LenGt":                "hossza nagyobb kell legyen, mint {length}",
+		"OneOf":                "a következők egyike kell legyen: {values}",
+		"IsKeyIn":              "nem megengedett érték",
+		"IsValueIn":            "nem megengedett érték",
+		"Not":                  "nem megengedett",
+		"Or":                   "egyik alternatívának sem felel meg",
+		"OneOfValidators":      "pontosan egy alternatívának kell megfeleljen",
+		"AtLeast":              "legalább {count} alternatívának kell megfeleljen",
+		"AtMost":               "legfeljebb {count} alternatívának felelhet meg",
+		"HasPrefix":            "{prefix} kezdetű kell legyen",
+		"HasSuffix":            "{suffix} végű kell legyen",
+		"Contains":             "tartalmaznia kell: {substr}",
+		"ContainsAny":          "tartalmaznia kell a következő karakterek egyikét: {chars}",
+		"Excludes":             "nem tartalmazhatja: {substr}",
+		"Lowercase":            "csak kisbetűket tartalmazhat",
+		"Uppercase":            "csak nagybetűket tartalmazhat",
+		"Trimmed":              "nem kezdődhet és nem végződhet szóközzel",
+		"NoWhitespace":         "nem tartalmazhat szóközt",
+		"SingleLine":           "egysoros kell legyen",
+		"EqFold":               "értéke {value} kell legyen",
+		"OneOfFold":            "a következők egyike kell legyen: {values}",
+		"GraphemeLenBw":        "{min} és {max} karakter közötti hosszúságú kell legyen",
+		"Type":                 "típusa {type} kell legyen",
+		"Minimum":              "legalább {value} kell legyen",
+		"Maximum":              "legfeljebb {value} lehet",
+		"MultipleOf":           "{value} többszöröse kell legyen",
+		"MinLength":            "legalább {length} karakter hosszú kell legyen",
+		"MaxLength":            "legfeljebb {length} karakter hosszú lehet",
+		"MinItems":             "legalább {length} elemet kell tartalmazzon",
+		"MaxItems":             "legfeljebb {length} elemet tartalmazhat",
+		"MinProperties":        "legalább {length} tulajdonsággal kell rendelkezzen",
+		"MaxProperties":        "legfeljebb {length} tulajdonsággal rendelkezhet",
+		"UniqueItems":          "nem tartalmazhat ismétlődő elemeket",
+		"Required":             "kötelező",
+		"AdditionalProperties": "nem megengedett tulajdonság",
+		"Int":                  "egész szám kell legyen",
+		"Float":                "szám kell legyen",
+		"Bool":                 "true vagy false kell legyen",
+		"Time":                 "{layout} formátumú időpont kell legyen",
+		"Duplicate":            "nem ismétlődhet",
+		"PhoneNumber":          "érvényes telefonszám kell legyen",
+		"PhoneNumberOfType":    "{types} típusú telefonszám kell legyen",
+		"URLWith":              "engedélyezett URL kell legyen",
+		"JWTWith":              "érvényes token kell legyen",
+		"Cron":                 "érvényes cron kifejezés kell legyen",
+		"PasswordPolicy":       "nem felel meg a jelszószabályzatnak",
+		"UUID":                 "UUID kell legyen",
+		"UUIDVersion":          "{versions} verziójú UUID kell legyen",
+		"UUIDVariantRFC9562":   "RFC 9562 szerinti UUID kell legyen",
+		"UUIDv7After":          "{time} után létrehozott 7-es verziójú UUID kell legyen",
+		"UUIDv7Before":         "{time} előtt létrehozott 7-es verziójú UUID kell legyen",
+		"ULID":                 "ULID kell legyen",
+		"ULIDAfter":            "{time} után létrehozott ULID kell legyen",
+		"ULIDBefore":           "{time} előtt létrehozott ULID kell legyen",
+		"NFC":                  "C Unicode normálformában kell legyen",
+		"NFKC":                 "KC Unicode normálformában kell legyen",
+		"Script":               "csak a(z) {scripts} írásrendszer karaktereit tartalmazhatja",
+		"NoMixedScripts":       "nem keverheti különböző írásrendszerek karaktereit",
+		"NotConfusable":        "túl hasonló egy már létező értékhez",
+		"NoControlChars":       "nem tartalmazhat vezérlőkaraktereket",
+		"NoBidiOverride":       "nem tartalmazhat kétirányú vezérlőkaraktereket",
+		"Dir":                  "létező könyvtár kell legyen",
+		"FileExists":           "létező fájl kell legyen",
+		"Readable":             "olvasható kell legyen",
+		"Executable":           "futtatható kell legyen",
+		"MaxFileSize":          "legfeljebb {size} bájt méretű fájl kell legyen",
+		"FileExtension":        "kiterjesztése a következők egyike kell legyen: {extensions}",
+		"MIMEType":             "{types} típusú fájl kell legyen",
+		"PathWithin":           "a(z) {root} könyvtáron belüli útvonal kell legyen",
+		"JSON":                 "érvényes JSON kell legyen",
+		"JSONObject":           "JSON objektum kell legyen",
+		"JSONArray":            "JSON tömb kell legyen",
+		"JSONPointer":          "JSON pointer kell legyen",
+		"JSONMatches":          "érvényes JSON kell legyen",
+		"Digest":               "érvényes hash érték kell legyen",
+		"DigestOf":             "a tartalom hash értéke kell legyen",
+		"DigestMatches":        "a tartalom hash értéke kell legyen",
+		"EmailWith":            "engedélyezett e-mail cím kell legyen",
+		"MIME":                 "{types} típusú tartalom kell legyen",
+		"Base64Decoded":        "érvényes base64 kell legyen",
+		"Base64URLDecoded":     "érvényes base64 kell legyen",
+		"Base64RawDecoded":     "érvényes base64 kell legyen",
+		"Base64RawURLDecoded":  "érvényes base64 kell legyen",
+		"HexDecoded":           "érvényes hexadecimális kell legyen",
+		"Iso3166":              "országkód kell legyen",
+		"Iso3166Alpha2":        "ISO 3166-1 alpha-2 országkód kell legyen",
+		"Iso3166Alpha3":        "ISO 3166-1 alpha-3 országkód kell legyen",
+		"Iso3166AlphaNumeric":  "ISO 3166-1 numerikus országkód kell legyen",
+		"Iso3166_2":            "ISO 3166-2 régiókód kell legyen",
+		"Iso4217":              "ISO 4217 pénznemkód kell legyen",
+		"Iso4217Numeric":       "ISO 4217 numerikus pénznemkód kell legyen",
+		"PostCodeByIso3166":    "érvényes {country} irányítószám kell legyen",
+	},
+	"fr": {
+		"Eq":                   "doit être {value}",
+		"Lt":                   "doit être inférieur à {value}",
+		"Gt":                   "doit être supérieur à {value}",
+		"Regexp":               "a un format invalide",
+		"LenEq":                "doit avoir une longueur de {length}",
+		"LenBw":                "doit avoir une longueur comprise entre {min} et {max}",
+		"LenLt":                "doit avoir une longueur inférieure à {length}",
+		"LenGt":                "doit avoir une longueur supérieure à {length}",
+		"OneOf":                "doit être l'une des valeurs {values}",
+		"IsKeyIn":              "n'est pas une valeur autorisée",
+		"IsValueIn":            "n'est pas une valeur autorisée",
+		"Not":                  "n'est pas autorisé",
+		"Or":                   "ne satisfait aucune des alternatives",
+		"OneOfValidators":      "doit satisfaire exactement une des alternatives",
+		"AtLeast":              "doit satisfaire au moins {count} des alternatives",
+		"AtMost":               "doit satisfaire au plus {count} des alternatives",
+		"HasPrefix":            "doit commencer par {prefix}",
+		"HasSuffix":            "doit se terminer par {suffix}",
+		"Contains":             "doit contenir {substr}",
+		"ContainsAny":          "doit contenir l'un des caractères {chars}",
+		"Excludes":             "ne doit pas contenir {substr}",
+		"Lowercase":            "doit être en minuscules",
+		"Uppercase":            "doit être en majuscules",
+		"Trimmed":              "ne doit pas commencer ni se terminer par un espace",
+		"NoWhitespace":         "ne doit pas contenir d'espace",
+		"SingleLine":           "doit tenir sur une seule ligne",
+		"EqFold":               "doit être {value}",
+		"OneOfFold":            "doit être l'une des valeurs {values}",
+		"GraphemeLenBw":        "doit contenir entre {min} et {max, plural, one {# caractère} other {# caractères}}",
+		"Type":                 "doit être de type {type}",
+		"Minimum":              "doit être supérieur ou égal à {value}",
+		"Maximum":              "doit être inférieur ou égal à {value}",
+		"MultipleOf":           "doit être un multiple de {value}",
+		"MinLength":            "doit contenir au moins {length, plural, one {# caractère} other {# caractères}}",
+		"MaxLength":            "doit contenir au plus {length, plural, one {# caractère} other {# caractères}}",
+		"MinItems":             "doit avoir au moins {length, plural, one {# élément} other {# éléments}}",
+		"MaxItems":             "doit avoir au plus {length, plural, one {# élément} other {# éléments}}",
+		"MinProperties":        "doit avoir au moins {length, plural, one {# propriété} other {# propriétés}}",
+		"MaxProperties":        "doit avoir au plus {length, plural, one {# propriété} other {# propriétés}}",
+		"UniqueItems":          "ne doit pas contenir de doublons",
+		"Required":             "est obligatoire",
+		"AdditionalProperties": "n'est pas une propriété autorisée",
+		"Int":                  "doit être un nombre entier",
+		"Float":                "doit être un nombre",
+		"Bool":                 "doit être true ou false",
+		"Time":                 "doit être une date au format {layout}",
+		"Duplicate":            "ne doit pas être répété",
+		"PhoneNumber":          "doit être un numéro de téléphone valide",
+		"PhoneNumberOfType":    "doit être un numéro de téléphone de type {types}",
+		"URLWith":              "doit être une URL autorisée",
+		"JWTWith":              "doit être un jeton valide",
+		"Cron":                 "doit être une expression cron valide",
+		"PasswordPolicy":       "ne respecte pas la politique de mots de passe",
+		"UUID":                 "doit être un UUID",
+		"UUIDVersion":          "doit être un UUID de version {versions}",
+		"UUIDVariantRFC9562":   "doit être un UUID conforme à la RFC 9562",
+		"UUIDv7After":          "doit être un UUID de version 7 créé après {time}",
+		"UUIDv7Before":         "doit être un UUID de version 7 créé avant {time}",
+		"ULID":                 "doit être un ULID",
+		"ULIDAfter":            "doit être un ULID créé après {time}",
+		"ULIDBefore":           "doit être un ULID créé avant {time}",
+		"NFC":                  "doit être en forme de normalisation Unicode C",
+		"NFKC":                 "doit être en forme de normalisation Unicode KC",
+		"Script":               "ne doit contenir que des caractères des écritures {scripts}",
+		"NoMixedScripts":       "ne doit pas mélanger des caractères d'écritures différentes",
+		"NotConfusable":        "est trop semblable à une valeur existante",
+		"NoControlChars":       "ne doit pas contenir de caractères de contrôle",
+		"NoBidiOverride":       "ne doit pas contenir de caractères de contrôle bidirectionnels",
+		"Dir":                  "doit être un répertoire existant",
+		"FileExists":           "doit être un fichier existant",
+		"Readable":             "doit être lisible",
+		"Executable":           "doit être exécutable",
+		"MaxFileSize":          "doit être un fichier d'au plus {size, plural, one {# octet} other {# octets}}",
+		"FileExtension":        "doit avoir l'une des extensions {extensions}",
+		"MIMEType":             "doit être un fichier de type {types}",
+		"PathWithin":           "doit être un chemin dans {root}",
+		"JSON":                 "doit être un JSON valide",
+		"JSONObject":           "doit être un objet JSON",
+		"JSONArray":            "doit être un tableau JSON",
+		"JSONPointer":          "doit être un pointeur JSON",
+		"JSONMatches":          "doit être un JSON valide",
+		"Digest":               "doit être une empreinte valide",
+		"DigestOf":             "doit être l'empreinte du contenu",
+		"DigestMatches":        "doit être l'empreinte du contenu",
+		"EmailWith":            "doit être une adresse e-mail autorisée",
+		"MIME":                 "doit être un contenu de type {types}",
+		"Base64Decoded":        "doit être du base64 valide",
+		"Base64URLDecoded":     "doit être du base64 valide",
+		"Base64RawDecoded":     "doit être du base64 valide",
+		"Base64RawURLDecoded":  "doit être du base64 valide",
+		"HexDecoded":           "doit être de l'hexadécimal valide",
+		"Iso3166":              "doit être un code de pays",
+		"Iso3166Alpha2":        "doit être un code de pays ISO 3166-1 alpha-2",
+		"Iso3166Alpha3":        "doit être un code de pays ISO 3166-1 alpha-3",
+		"Iso3166AlphaNumeric":  "doit être un code de pays numérique ISO 3166-1",
+		"Iso3166_2":            "doit être un code de subdivision ISO 3166-2",
+		"Iso4217":              "doit être un code de devise ISO 4217",
+		"Iso4217Numeric":       "doit être un code de devise numérique ISO 4217",
+		"PostCodeByIso3166":    "doit être un code postal valide de {country}",
+	},
+}
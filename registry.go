@@ -0,0 +1,413 @@
+// Pluggable reference data (country, currency, subdivision and postcode code lists) used by the
+// ISO validators. The bundled data is used by default, but it can be overridden at startup with
+// data loaded from JSON or CSV, e.g. from an embed.FS:
+//
+//	//go:embed codes.json
+//	var codes embed.FS
+//
+//	func init() {
+//		r, err := fv.ReadRegistryFS(fv.BundledRegistry(), codes, "codes.json")
+//		if err != nil {
+//			panic(err)
+//		}
+//		fv.SetRegistry(r)
+//	}
+package funcvalid
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync/atomic"
+)
+
+// Registry is the source of the reference data that the ISO validators resolve through.
+type Registry interface {
+	// Returns the country for an alpha-2, alpha-3 or numeric code.
+	Country(code string) (Country, bool)
+	// Returns all the countries sorted by their alpha-2 codes.
+	Countries() []Country
+	// Reports if the code is an ISO 4217 currency code.
+	Currency(code string) bool
+	// Reports if the code is an ISO 4217 numeric currency code.
+	CurrencyNumeric(code int) bool
+	// Reports if the code is an ISO 3166-2 subdivision code.
+	Subdivision(code string) bool
+	// Returns the postcode regexp of a country given by any of its code forms.
+	PostCode(countryCode string) (*regexp.Regexp, bool)
+}
+
+// RegistryOverride is a set of changes applied on the top of a base registry. Countries
+// are matched by their alpha-2 code, and an empty postcode pattern removes the rule of the country.
+type RegistryOverride struct {
+	Countries               []Country         `json:"countries"`
+	RemoveCountries         []string          `json:"removeCountries"`
+	Currencies              []string          `json:"currencies"`
+	RemoveCurrencies        []string          `json:"removeCurrencies"`
+	CurrenciesNumeric       []int             `json:"currenciesNumeric"`
+	RemoveCurrenciesNumeric []int             `json:"removeCurrenciesNumeric"`
+	Subdivisions            []string          `json:"subdivisions"`
+	RemoveSubdivisions      []string          `json:"removeSubdivisions"`
+	PostCodes               map[string]string `json:"postCodes"`
+}
+
+type registryBox struct{ Registry }
+
+var currentRegistry atomic.Value
+
+func init() {
+	currentRegistry.Store(registryBox{bundled})
+}
+
+// Returns the registry of the data bundled with the package.
+func BundledRegistry() Registry {
+	return bundled
+}
+
+// Returns the registry that the validators currently resolve through.
+func CurrentRegistry() Registry {
+	return currentRegistry.Load().(registryBox).Registry
+}
+
+// Sets the registry that the validators resolve through. It's meant to be called at startup,
+// a nil registry restores the bundled one.
+func SetRegistry(r Registry) {
+	if r == nil {
+		r = bundled
+	}
+	currentRegistry.Store(registryBox{r})
+}
+
+// Returns a registry that applies the override on the top of the base registry.
+func NewRegistry(base Registry, o RegistryOverride) (Registry, error) {
+	r := &overrideRegistry{
+		base:              base,
+		countries:         map[string]Country{},
+		removedCountries:  map[string]bool{},
+		currencies:        map[string]bool{},
+		currenciesNumeric: map[int]bool{},
+		subdivisions:      map[string]bool{},
+		postCodes:         map[string]*regexp.Regexp{},
+	}
+	for _, c := range o.Countries {
+		if len(c.Alpha2) != 2 || len(c.Alpha3) != 3 {
+			return nil, fmt.Errorf("error: invalid country %q", c.Alpha2)
+		}
+		r.countries[c.Alpha2] = c
+	}
+	for _, code := range o.RemoveCountries {
+		c, ok := base.Country(code)
+		if !ok {
+			return nil, fmt.Errorf("error: unknown country %q", code)
+		}
+		r.removedCountries[c.Alpha2] = true
+	}
+	for _, code := range o.Currencies {
+		r.currencies[code] = true
+	}
+	for _, code := range o.RemoveCurrencies {
+		r.currencies[code] = false
+	}
+	for _, code := range o.CurrenciesNumeric {
+		r.currenciesNumeric[code] = true
+	}
+	for _, code := range o.RemoveCurrenciesNumeric {
+		r.currenciesNumeric[code] = false
+	}
+	for _, code := range o.Subdivisions {
+		r.subdivisions[code] = true
+	}
+	for _, code := range o.RemoveSubdivisions {
+		r.subdivisions[code] = false
+	}
+	for code, pattern := range o.PostCodes {
+		if pattern == "" {
+			r.postCodes[code] = nil
+			continue
+		}
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("error: invalid postcode pattern for %q: %w", code, err)
+		}
+		r.postCodes[code] = re
+	}
+	return r, nil
+}
+
+// Reads an override in JSON format (see RegistryOverride) and applies it on the top of the base registry.
+func ReadRegistryJSON(base Registry, r io.Reader) (Registry, error) {
+	var o RegistryOverride
+	dec := json.NewDecoder(r)
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&o); err != nil {
+		return nil, fmt.Errorf("error: registry json: %w", err)
+	}
+	return NewRegistry(base, o)
+}
+
+// Reads an override in CSV format and applies it on the top of the base registry.
+// Each record starts with an operation (add or remove) and a kind, followed by the code:
+//
+//	# op,kind,code[,...]
+//	remove,currency,HRK
+//	add,currency_numeric,926
+//	add,subdivision,HU-BU
+//	add,country,XK,XKX,0,Kosovo,true
+//	add,postcode,HU,^\d{4}$
+func ReadRegistryCSV(base Registry, r io.Reader) (Registry, error) {
+	reader := csv.NewReader(r)
+	reader.Comment = '#'
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+	var o RegistryOverride
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("error: registry csv: %w", err)
+		}
+		if err := o.addCSVRecord(record); err != nil {
+			line, _ := reader.FieldPos(0)
+			return nil, fmt.Errorf("error: registry csv line %d: %w", line, err)
+		}
+	}
+	return NewRegistry(base, o)
+}
+
+// Reads an override file from a file system (e.g. an embed.FS) and applies it on the top of
+// the base registry. The format is selected by the extension of the file (.json or .csv).
+func ReadRegistryFS(base Registry, fsys fs.FS, name string) (Registry, error) {
+	f, err := fsys.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	switch strings.ToLower(path.Ext(name)) {
+	case ".json":
+		return ReadRegistryJSON(base, f)
+	case ".csv":
+		return ReadRegistryCSV(base, f)
+	}
+	return nil, fmt.Errorf("error: unknown registry format %q", name)
+}
+
+func (o *RegistryOverride) addCSVRecord(record []string) error {
+	if len(record) < 3 {
+		return errors.New("too few fields")
+	}
+	op, kind, code := record[0], record[1], record[2]
+	if op != "add" && op != "remove" {
+		return fmt.Errorf("unknown operation %q", op)
+	}
+	remove := op == "remove"
+	switch kind {
+	case "country":
+		if remove {
+			o.RemoveCountries = append(o.RemoveCountries, code)
+			return nil
+		}
+		if len(record) != 7 {
+			return errors.New("country needs alpha2,alpha3,numeric,name,postcode fields")
+		}
+		numeric, err := strconv.Atoi(record[4])
+		if err != nil {
+			return err
+		}
+		postCode, err := strconv.ParseBool(record[6])
+		if err != nil {
+			return err
+		}
+		o.Countries = append(o.Countries, Country{code, record[3], numeric, record[5], postCode})
+	case "currency":
+		if remove {
+			o.RemoveCurrencies = append(o.RemoveCurrencies, code)
+		} else {
+			o.Currencies = append(o.Currencies, code)
+		}
+	case "currency_numeric":
+		numeric, err := strconv.Atoi(code)
+		if err != nil {
+			return err
+		}
+		if remove {
+			o.RemoveCurrenciesNumeric = append(o.RemoveCurrenciesNumeric, numeric)
+		} else {
+			o.CurrenciesNumeric = append(o.CurrenciesNumeric, numeric)
+		}
+	case "subdivision":
+		if remove {
+			o.RemoveSubdivisions = append(o.RemoveSubdivisions, code)
+		} else {
+			o.Subdivisions = append(o.Subdivisions, code)
+		}
+	case "postcode":
+		if o.PostCodes == nil {
+			o.PostCodes = map[string]string{}
+		}
+		if remove {
+			o.PostCodes[code] = ""
+			return nil
+		}
+		if len(record) != 4 {
+			return errors.New("postcode needs code,pattern fields")
+		}
+		o.PostCodes[code] = record[3]
+	default:
+		return fmt.Errorf("unknown kind %q", kind)
+	}
+	return nil
+}
+
+// The registry of the bundled data.
+type bundledRegistry struct{}
+
+var bundled Registry = bundledRegistry{}
+
+func (bundledRegistry) Country(code string) (Country, bool) {
+	switch len(code) {
+	case 2:
+		c, ok := countryByAlpha2[code]
+		return c, ok
+	case 3:
+		if c, ok := countryByAlpha3[code]; ok {
+			return c, true
+		}
+		if n, err := strconv.Atoi(code); err == nil && n > 0 {
+			c, ok := countryByNumeric[n]
+			return c, ok
+		}
+	}
+	return Country{}, false
+}
+
+func (bundledRegistry) Countries() []Country {
+	result := make([]Country, len(countries))
+	copy(result, countries)
+	sortCountries(result)
+	return result
+}
+
+func (bundledRegistry) Currency(code string) bool {
+	return iso4217[code]
+}
+
+func (bundledRegistry) CurrencyNumeric(code int) bool {
+	return iso4217_numeric[code]
+}
+
+func (bundledRegistry) Subdivision(code string) bool {
+	return iso3166_2[code]
+}
+
+func (r bundledRegistry) PostCode(countryCode string) (*regexp.Regexp, bool) {
+	if re, ok := postCodeRegexDict[countryCode]; ok {
+		return re, true
+	}
+	if c, ok := r.Country(countryCode); ok {
+		re, ok := postCodeRegexDict[c.Alpha2]
+		return re, ok
+	}
+	return nil, false
+}
+
+// The registry that applies an override on the top of a base registry.
+type overrideRegistry struct {
+	base              Registry
+	countries         map[string]Country
+	removedCountries  map[string]bool
+	currencies        map[string]bool
+	currenciesNumeric map[int]bool
+	subdivisions      map[string]bool
+	postCodes         map[string]*regexp.Regexp
+}
+
+func (r *overrideRegistry) Country(code string) (Country, bool) {
+	for _, c := range r.countries {
+		if code == c.Alpha2 || code == c.Alpha3 || (c.Numeric != 0 && code == fmt.Sprintf("%03d", c.Numeric)) {
+			return c, true
+		}
+	}
+	c, ok := r.base.Country(code)
+	if !ok || r.removedCountries[c.Alpha2] {
+		return Country{}, false
+	}
+	if _, replaced := r.countries[c.Alpha2]; replaced {
+		return Country{}, false
+	}
+	return c, true
+}
+
+func (r *overrideRegistry) Countries() []Country {
+	var result []Country
+	for _, c := range r.base.Countries() {
+		if _, replaced := r.countries[c.Alpha2]; !replaced && !r.removedCountries[c.Alpha2] {
+			result = append(result, c)
+		}
+	}
+	for _, c := range r.countries {
+		result = append(result, c)
+	}
+	sortCountries(result)
+	return result
+}
+
+func sortCountries(countries []Country) {
+	sort.Slice(countries, func(i, j int) bool {
+		return countries[i].Alpha2 < countries[j].Alpha2
+	})
+}
+
+func (r *overrideRegistry) Currency(code string) bool {
+	if valid, ok := r.currencies[code]; ok {
+		return valid
+	}
+	return r.base.Currency(code)
+}
+
+func (r *overrideRegistry) CurrencyNumeric(code int) bool {
+	if valid, ok := r.currenciesNumeric[code]; ok {
+		return valid
+	}
+	return r.base.CurrencyNumeric(code)
+}
+
+func (r *overrideRegistry) Subdivision(code string) bool {
+	if valid, ok := r.subdivisions[code]; ok {
+		return valid
+	}
+	return r.base.Subdivision(code)
+}
+
+func (r *overrideRegistry) PostCode(countryCode string) (*regexp.Regexp, bool) {
+	key := countryCode
+	if c, ok := r.Country(countryCode); ok {
+		key = c.Alpha2
+	} else if _, ok := r.base.Country(countryCode); ok {
+		return nil, false
+	}
+	if re, ok := r.postCodes[key]; ok {
+		return re, re != nil
+	}
+	return r.base.PostCode(key)
+}
+
+// Factory function that returns a validator, that validates if the input is found in the
+// current registry by the lookup function.
+func inRegistry[T any](name string, lookup func(r Registry, inp T) bool) Validator[T] {
+	return withRule(newRule(name), func(inp T) error {
+		if lookup(CurrentRegistry(), inp) {
+			return nil
+		}
+		return ruleError(name)
+	})
+}
@@ -0,0 +1,189 @@
+// Validator introspection: the validators of the package report the rules they check as a Rule tree,
+// that can be serialized to JSON (e.g. for client-side hints), or described in a sentence (e.g. for
+// API documentation):
+//
+//	username := fv.And(fv.LenBw[string](3, 20), fv.NoWhitespace)
+//	fv.Describe(username) // -> "must have a length between 3 and 20 and must not contain whitespace"
+//
+// The rules are kept in a side registry keyed by the validator closures, since the validators are plain
+// functions. The validators that aren't created by the factories of the package are reported by the
+// names of their functions (see RuleOf), or they can be described explicitly with WithRule. The rules
+// of the unreachable validators are released with Go 1.24 or later (see rule_cleanup.go). With older
+// Go versions the rules of the last 65536 validators are kept (see rule_nocleanup.go), so the
+// validators should be created once (e.g. as package variables) rather than per request, otherwise
+// the rules of the earlier validators are dropped.
+package funcvalid
+
+import (
+	"runtime"
+	"strings"
+	"sync"
+	"unsafe"
+)
+
+// Rule is the description of a validator: its name, parameters and the rules of the validators
+// that it is composed of (e.g. of And, Or, Not and Each).
+type Rule struct {
+	Name     string         `json:"name"`
+	Params   map[string]any `json:"params,omitempty"`
+	Children []Rule         `json:"children,omitempty"`
+}
+
+type ruleEntry struct {
+	code uintptr // the code pointer of the closure, so a reused address isn't mistaken for the validator
+	rule Rule
+}
+
+// The rules of the validators keyed by the addresses of their closures.
+var rules sync.Map
+
+// Returns the address of the closure and its code pointer.
+func funcPointers[T any](v Validator[T]) (unsafe.Pointer, uintptr) {
+	p := *(*unsafe.Pointer)(unsafe.Pointer(&v))
+	if p == nil {
+		return nil, 0
+	}
+	return p, *(*uintptr)(p)
+}
+
+// Registers the rule of the validator, and returns the validator.
+func withRule[T any](rule Rule, v Validator[T]) Validator[T] {
+	p, code := funcPointers(v)
+	key := uintptr(p)
+	entry := &ruleEntry{code, rule}
+	rules.Store(key, entry)
+	releaseRule(p, entry)
+	return v
+}
+
+// Returns a rule with the name and the parameters given as key-value pairs.
+func newRule(name string, keyValues ...any) Rule {
+	rule := Rule{Name: name}
+	if len(keyValues) > 0 {
+		rule.Params = make(map[string]any, len(keyValues)/2)
+		for i := 0; i+1 < len(keyValues); i += 2 {
+			rule.Params[keyValues[i].(string)] = keyValues[i+1]
+		}
+	}
+	return rule
+}
+
+// Returns a rule with the name and the rules of the validators as children.
+func compositeRule[T any](name string, validators []Validator[T], keyValues ...any) Rule {
+	rule := newRule(name, keyValues...)
+	for _, v := range validators {
+		rule.Children = append(rule.Children, RuleOf(v))
+	}
+	return rule
+}
+
+func notRule[T any](validator Validator[T], description []string) Rule {
+	rule := compositeRule("Not", []Validator[T]{validator})
+	if len(description) > 0 {
+		rule.Params = map[string]any{"description": strings.Join(description, " ")}
+	}
+	return rule
+}
+
+// Factory function with a validator and a rule parameter that returns a validator, that validates the
+// input with the parameter validator, and reports the rule (see RuleOf).
+func WithRule[T any](validator Validator[T], rule Rule) Validator[T] {
+	return withRule(rule, func(inp T) error {
+		return validator(inp)
+	})
+}
+
+// Returns the rule of the validator. The rule of the validators that aren't created by the factories
+// of the package (or WithRule) is named after the function of the validator, e.g. "Lowercase".
+func RuleOf[T any](v Validator[T]) Rule {
+	p, code := funcPointers(v)
+	if p == nil {
+		return Rule{}
+	}
+	if entry, ok := rules.Load(uintptr(p)); ok && entry.(*ruleEntry).code == code {
+		return entry.(*ruleEntry).rule
+	}
+	name := ""
+	if f := runtime.FuncForPC(code); f != nil {
+		name = f.Name()
+	}
+	// e.g. "github.com/user/pkg.Factory[...].func1" -> "Factory"
+	for strings.Contains(name, "[") {
+		start := strings.Index(name, "[")
+		end := strings.Index(name[start:], "]")
+		if end < 0 {
+			break
+		}
+		name = name[:start] + name[start+end+1:]
+	}
+	name = name[strings.LastIndex(name, "/")+1:]
+	parts := strings.Split(name, ".")
+	for len(parts) > 2 && (strings.HasPrefix(parts[len(parts)-1], "func") || parts[len(parts)-1] == "") {
+		parts = parts[:len(parts)-1]
+	}
+	return Rule{Name: parts[len(parts)-1]}
+}
+
+// Returns the English description of the rules of the validator in a sentence (see Describe).
+func Describe[T any](v Validator[T]) string {
+	return NewTranslator().Describe(RuleOf(v), "en")
+}
+
+// Returns the description of the rule in a sentence in the locale. The leaf rules are described by the
+// messages of their codes (or by their names if there is no message), and the composite rules by
+// connecting the descriptions of their children.
+func (t *Translator) Describe(rule Rule, locale string) string {
+	children := make([]string, len(rule.Children))
+	for i, child := range rule.Children {
+		children[i] = t.Describe(child, locale)
+		if len(child.Children) > 1 {
+			children[i] = "(" + children[i] + ")"
+		}
+	}
+	switch rule.Name {
+	case "And":
+		return strings.Join(children, " and ")
+	case "Or":
+		return strings.Join(children, " or ")
+	case "Not":
+		if description, ok := rule.Params["description"]; ok {
+			return "must not be " + formatParam(description)
+		}
+		return negateDescription(strings.Join(children, ""))
+	case "Each":
+		return "each element " + strings.Join(children, "")
+	case "OneOfValidators":
+		return "exactly one of: " + strings.Join(children, "; ")
+	case "AtLeast":
+		return "at least " + formatParam(rule.Params["count"]) + " of: " + strings.Join(children, "; ")
+	case "AtMost":
+		return "at most " + formatParam(rule.Params["count"]) + " of: " + strings.Join(children, "; ")
+	case "WithMessage", "WithCode", "WithErrorf", "Deref":
+		return strings.Join(children, "")
+	case "Struct":
+		return strings.Join(children, "; ")
+	case "Field":
+		return formatParam(rule.Params["name"]) + " " + strings.Join(children, "")
+	case "OmitEmpty":
+		return "if not empty, " + strings.Join(children, "")
+	}
+	if msg, ok := t.Message(rule.Name, rule.Params, locale); ok {
+		return msg
+	}
+	desc := rule.Name
+	if len(children) > 0 {
+		desc += " (" + strings.Join(children, ", ") + ")"
+	}
+	return desc
+}
+
+// Negates the description of a rule, e.g. "must be x" -> "must not be x".
+func negateDescription(desc string) string {
+	if rest, ok := strings.CutPrefix(desc, "must not "); ok {
+		return "must " + rest
+	}
+	if rest, ok := strings.CutPrefix(desc, "must "); ok {
+		return "must not " + rest
+	}
+	return "must not satisfy " + desc
+}
@@ -0,0 +1,17 @@
+//go:build go1.24
+
+// Release of the rules of the unreachable validators.
+package funcvalid
+
+import (
+	"runtime"
+	"unsafe"
+)
+
+// Removes the rule entry from the registry when the closure at the address becomes unreachable.
+func releaseRule(p unsafe.Pointer, entry *ruleEntry) {
+	// the closures without captured variables aren't heap allocated, and they live forever anyway
+	defer func() { _ = recover() }()
+	key := uintptr(p)
+	runtime.AddCleanup((*byte)(p), func(e *ruleEntry) { rules.CompareAndDelete(key, e) }, entry)
+}
@@ -0,0 +1,39 @@
+//go:build !go1.24
+
+// The rules of the validators can't be released when their closures become unreachable before Go 1.24
+// (that added runtime.AddCleanup), since a finalizer can't be set on the closures without captured
+// variables. The registry is bounded instead: it keeps the rules of the last maxRules validators, and
+// the validators whose rules are dropped are reported by the names of their functions (see RuleOf).
+package funcvalid
+
+import (
+	"sync"
+	"unsafe"
+)
+
+// The maximum number of the rules kept in the registry.
+const maxRules = 1 << 16
+
+// The registered rule entries in the order of their registration, as a ring buffer.
+var ruleRing struct {
+	sync.Mutex
+	keys    []uintptr
+	entries []*ruleEntry
+	next    int
+}
+
+// Drops the oldest rule entry from the registry if it has maxRules entries.
+func releaseRule(p unsafe.Pointer, entry *ruleEntry) {
+	ruleRing.Lock()
+	defer ruleRing.Unlock()
+	if len(ruleRing.entries) < maxRules {
+		ruleRing.keys = append(ruleRing.keys, uintptr(p))
+		ruleRing.entries = append(ruleRing.entries, entry)
+		return
+	}
+	i := ruleRing.next
+	// the entry may have been replaced by a validator at the same address since
+	rules.CompareAndDelete(ruleRing.keys[i], ruleRing.entries[i])
+	ruleRing.keys[i], ruleRing.entries[i] = uintptr(p), entry
+	ruleRing.next = (i + 1) % maxRules
+}
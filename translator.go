@@ -0,0 +1,246 @@
+// Translator that renders localized messages of the RuleErrors from message catalogs, e.g.:
+//
+//	t := fv.NewTranslator()
+//	t.Translate(fv.LenBw[string](3, 20)("ab"), "de-AT") // -> "muss eine Länge zwischen 3 und 20 haben"
+//
+// The messages are templates keyed by the rule codes, where "{name}" is replaced with the parameter
+// of the rule, and "{name, plural, one {# item} other {# items}}" selects the form by the plural
+// category of the numeric parameter ("#" is replaced with the number, "=0 {...}" matches exactly).
+package funcvalid
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// Catalog maps the rule codes to message templates.
+type Catalog map[string]string
+
+// PluralRule returns the plural category ("zero", "one", "two", "few", "many" or "other") of the number.
+type PluralRule func(n float64) string
+
+// Translator renders the messages of the errors from the bundled (English, German, Hungarian and French)
+// and custom catalogs. It should be configured before it's used from multiple goroutines.
+type Translator struct {
+	catalogs map[string]Catalog
+	plurals  map[string]PluralRule
+	fallback []string
+}
+
+// Returns a translator with the bundled catalogs. The locales in the parameters are looked up after
+// the requested locale (and its parent locales), and English is the last resort.
+func NewTranslator(fallback ...string) *Translator {
+	t := &Translator{
+		catalogs: map[string]Catalog{},
+		plurals:  map[string]PluralRule{},
+		fallback: append(append([]string{}, fallback...), "en"),
+	}
+	for locale, catalog := range bundledCatalogs {
+		t.AddCatalog(locale, catalog)
+	}
+	for lang, rule := range bundledPluralRules {
+		t.plurals[lang] = rule
+	}
+	return t
+}
+
+// Adds the messages of the catalog to the locale (e.g. "en" or "de-AT"), overriding the existing ones.
+func (t *Translator) AddCatalog(locale string, catalog Catalog) {
+	locale = normalizeLocale(locale)
+	if t.catalogs[locale] == nil {
+		t.catalogs[locale] = Catalog{}
+	}
+	for code, msg := range catalog {
+		t.catalogs[locale][code] = msg
+	}
+}
+
+// Reads a catalog from a JSON object of code-message pairs, and adds it to the locale (see AddCatalog).
+func (t *Translator) LoadCatalogJSON(locale string, r io.Reader) error {
+	var catalog Catalog
+	if err := json.NewDecoder(r).Decode(&catalog); err != nil {
+		return err
+	}
+	t.AddCatalog(locale, catalog)
+	return nil
+}
+
+// Sets the plural rule of the language (e.g. "en").
+func (t *Translator) SetPluralRule(lang string, rule PluralRule) {
+	t.plurals[normalizeLocale(lang)] = rule
+}
+
+// Returns the localized message of the error. The custom messages (see WithMessage) are returned as they
+// are. If the error isn't a RuleError (and doesn't wrap one), or no catalog in the fallback chain has a
+// message for its code (or the codes of the wrapped RuleErrors), the message of the error is returned.
+func (t *Translator) Translate(err error, locale string) string {
+	if err == nil {
+		return ""
+	}
+	if msg, ok := t.translate(err, locale); ok {
+		return msg
+	}
+	return err.Error()
+}
+
+// Translates the first error of the error tree (in depth-first order) that has a message.
+func (t *Translator) translate(err error, locale string) (string, bool) {
+	switch e := err.(type) {
+	case *MessageError:
+		return e.Message, true
+	case *RuleError:
+		if msg, ok := t.Message(e.Code, e.Params, locale); ok {
+			return msg, true
+		}
+		for _, inner := range e.Errs {
+			if msg, ok := t.translate(inner, locale); ok {
+				return msg, true
+			}
+		}
+	}
+	switch e := err.(type) {
+	case interface{ Unwrap() error }:
+		if inner := e.Unwrap(); inner != nil {
+			return t.translate(inner, locale)
+		}
+	case interface{ Unwrap() []error }:
+		for _, inner := range e.Unwrap() {
+			if msg, ok := t.translate(inner, locale); ok {
+				return msg, true
+			}
+		}
+	}
+	return "", false
+}
+
+// Renders the message of the code with the parameters in the locale, looking up the locale, its parent
+// locales (e.g. "de" for "de-AT") and the fallback locales in order.
+func (t *Translator) Message(code string, params map[string]any, locale string) (string, bool) {
+	for _, l := range t.chain(locale) {
+		if msg, ok := t.catalogs[l][code]; ok {
+			lang, _, _ := strings.Cut(l, "-")
+			plural := t.plurals[lang]
+			if plural == nil {
+				plural = pluralOne
+			}
+			return renderMessage(msg, params, plural), true
+		}
+	}
+	return "", false
+}
+
+func (t *Translator) chain(locale string) []string {
+	var chain []string
+	for _, l := range append([]string{locale}, t.fallback...) {
+		for l = normalizeLocale(l); l != ""; {
+			chain = append(chain, l)
+			i := strings.LastIndex(l, "-")
+			if i < 0 {
+				break
+			}
+			l = l[:i]
+		}
+	}
+	return chain
+}
+
+func normalizeLocale(locale string) string {
+	return strings.ToLower(strings.ReplaceAll(locale, "_", "-"))
+}
+
+// Renders the template with the parameters (see the syntax in the file comment).
+func renderMessage(msg string, params map[string]any, plural PluralRule) string {
+	var b strings.Builder
+	for {
+		start := strings.IndexByte(msg, '{')
+		if start < 0 {
+			b.WriteString(msg)
+			return b.String()
+		}
+		end := matchingBrace(msg, start)
+		if end < 0 {
+			b.WriteString(msg)
+			return b.String()
+		}
+		b.WriteString(msg[:start])
+		b.WriteString(renderPlaceholder(msg[start+1:end], params, plural))
+		msg = msg[end+1:]
+	}
+}
+
+func matchingBrace(s string, start int) int {
+	depth := 0
+	for i := start; i < len(s); i++ {
+		switch s[i] {
+		case '{':
+			depth++
+		case '}':
+			if depth--; depth == 0 {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+func renderPlaceholder(placeholder string, params map[string]any, plural PluralRule) string {
+	name, rest, isPlural := strings.Cut(placeholder, ",")
+	name = strings.TrimSpace(name)
+	value, ok := params[name]
+	if !ok {
+		return "{" + placeholder + "}"
+	}
+	if !isPlural {
+		return formatParam(value)
+	}
+	kind, forms, _ := strings.Cut(rest, ",")
+	n, err := strconv.ParseFloat(formatParam(value), 64)
+	if strings.TrimSpace(kind) != "plural" || err != nil {
+		return formatParam(value)
+	}
+	exact, selected, other := "", "", ""
+	category := plural(n)
+	for forms = strings.TrimSpace(forms); forms != ""; {
+		open := strings.IndexByte(forms, '{')
+		if open < 0 {
+			break
+		}
+		end := matchingBrace(forms, open)
+		if end < 0 {
+			break
+		}
+		selector, form := strings.TrimSpace(forms[:open]), forms[open+1:end]
+		switch selector {
+		case "=" + formatParam(value):
+			exact = form
+		case category:
+			selected = form
+		}
+		if selector == "other" {
+			other = form
+		}
+		forms = strings.TrimSpace(forms[end+1:])
+	}
+	if exact != "" {
+		selected = exact
+	} else if selected == "" {
+		selected = other
+	}
+	return strings.ReplaceAll(renderMessage(selected, params, plural), "#", formatParam(value))
+}
+
+// Formats the parameter, the elements of the slices are separated with commas.
+func formatParam(value any) string {
+	if v := reflect.ValueOf(value); v.Kind() == reflect.Slice && v.Type().Elem().Kind() != reflect.Uint8 {
+		elems := make([]string, v.Len())
+		for i := range elems {
+			elems[i] = formatParam(v.Index(i).Interface())
+		}
+		return strings.Join(elems, ", ")
+	}
+	return fmt.Sprint(value)
+}
@@ -4,6 +4,7 @@ package funcvalid
 
 import (
 	"errors"
+	"net/netip"
 	"net/url"
 	"os"
 	"strings"
@@ -39,7 +40,6 @@ var (
 	UUID4RFC4122          = RegexpRE(uUID4RFC4122Regex)
 	UUID5RFC4122          = RegexpRE(uUID5RFC4122Regex)
 	UUIDRFC4122           = RegexpRE(uUIDRFC4122Regex)
-	ULID                  = RegexpRE(uLIDRegex)
 	Md4                   = RegexpRE(md4Regex)
 	Md5                   = RegexpRE(md5Regex)
 	Sha256                = RegexpRE(sha256Regex)
@@ -74,23 +74,39 @@ var (
 	DnsRFC1035Label       = RegexpRE(dnsRegexRFC1035Label)
 	Cve                   = RegexpRE(cveRegex)
 	Mongodb               = RegexpRE(mongodbRegex)
-	Cron                  = RegexpRE(cronRegex)
 	SpicedbID             = RegexpRE(spicedbIDRegex)
 	SpicedbPermission     = RegexpRE(spicedbPermissionRegex)
 	SpicedbType           = RegexpRE(spicedbTypeRegex)
 )
 
 var (
-	Iso3166Alpha2       = KeyIn(iso3166_1_alpha2)
-	Iso3166Alpha3       = KeyIn(iso3166_1_alpha3)
-	Iso3166AlphaNumeric = KeyIn(iso3166_1_alpha_numeric)
-	Iso4217             = KeyIn(iso4217)
-	Iso4217Numeric      = KeyIn(iso4217_numeric)
-	PostCodeByIso3166   = func(country_code string) Validator[string] {
-		if _, ok := postCodePatternDict[country_code]; !ok {
-			return ErrorValidator[string]("invalid country code")
-		}
-		return Regexp(postCodePatternDict[country_code])
+	Iso3166Alpha2 = inRegistry("Iso3166Alpha2", func(r Registry, code string) bool {
+		c, ok := r.Country(code)
+		return ok && c.Alpha2 == code
+	})
+	Iso3166Alpha3 = inRegistry("Iso3166Alpha3", func(r Registry, code string) bool {
+		c, ok := r.Country(code)
+		return ok && c.Alpha3 == code
+	})
+	Iso3166AlphaNumeric = inRegistry("Iso3166AlphaNumeric", func(r Registry, code int) bool {
+		_, ok := countryByNumericCode(r, code)
+		return ok || legacyNumericCodes[code]
+	})
+	Iso3166_2      = inRegistry("Iso3166_2", Registry.Subdivision)
+	Iso4217        = inRegistry("Iso4217", Registry.Currency)
+	Iso4217Numeric = inRegistry("Iso4217Numeric", Registry.CurrencyNumeric)
+	// PostCodeByIso3166 accepts the alpha-2, alpha-3 or numeric code of the country.
+	PostCodeByIso3166 = func(country_code string) Validator[string] {
+		return withRule(newRule("PostCodeByIso3166", "country", country_code), func(inp string) error {
+			re, ok := CurrentRegistry().PostCode(country_code)
+			if !ok {
+				return ruleError("PostCodeByIso3166", "country", country_code, "description", "invalid country code")
+			}
+			if re.MatchString(inp) {
+				return nil
+			}
+			return ruleError("PostCodeByIso3166", "country", country_code)
+		})
 	}
 )
 
@@ -157,3 +173,27 @@ func File(input string) error {
 
 	return errors.New("error: File")
 }
+
+// Ip is the validation function for validating if the input is a valid IPv4 or IPv6 address.
+func Ip(input string) error {
+	if addr, err := netip.ParseAddr(input); err == nil && addr.Zone() == "" {
+		return nil
+	}
+	return errors.New("error: Ip")
+}
+
+// Ipv4 is the validation function for validating if the input is a valid IPv4 address in dotted decimal notation.
+func Ipv4(input string) error {
+	if addr, err := netip.ParseAddr(input); err == nil && addr.Is4() {
+		return nil
+	}
+	return errors.New("error: Ipv4")
+}
+
+// Ipv6 is the validation function for validating if the input is a valid IPv6 address (without zone).
+func Ipv6(input string) error {
+	if addr, err := netip.ParseAddr(input); err == nil && addr.Is6() && addr.Zone() == "" {
+		return nil
+	}
+	return errors.New("error: Ipv6")
+}
@@ -0,0 +1,336 @@
+// Country records of ISO 3166-1 that link the alpha-2, alpha-3 and numeric code forms
+// together with the official short name and the postcode usage of the country.
+package funcvalid
+
+import "fmt"
+
+// Country is the ISO 3166-1 record of a country.
+type Country struct {
+	Alpha2   string `json:"alpha2"`   // ISO 3166-1 alpha-2 code, e.g. "HU"
+	Alpha3   string `json:"alpha3"`   // ISO 3166-1 alpha-3 code, e.g. "HUN"
+	Numeric  int    `json:"numeric"`  // ISO 3166-1 numeric code, e.g. 348 (0 if the country has none)
+	Name     string `json:"name"`     // official short name in English
+	PostCode bool   `json:"postCode"` // true if the country has a postcode pattern (see PostCodeByIso3166)
+}
+
+// Returns the country record for an alpha-2 ("HU"), alpha-3 ("HUN") or numeric ("348") code
+// from the current registry.
+func CountryByCode(code string) (Country, bool) {
+	return CurrentRegistry().Country(code)
+}
+
+// Returns the country record for a numeric code from the current registry.
+func CountryByNumeric(code int) (Country, bool) {
+	return countryByNumericCode(CurrentRegistry(), code)
+}
+
+func countryByNumericCode(r Registry, code int) (Country, bool) {
+	if code <= 0 || code > 999 {
+		return Country{}, false
+	}
+	return r.Country(fmt.Sprintf("%03d", code))
+}
+
+// Returns all the country records of the current registry.
+func Countries() []Country {
+	return CurrentRegistry().Countries()
+}
+
+// Converts any code form of a country to its alpha-2 code.
+func Iso3166ToAlpha2(code string) (string, bool) {
+	c, ok := CountryByCode(code)
+	return c.Alpha2, ok
+}
+
+// Converts any code form of a country to its alpha-3 code.
+func Iso3166ToAlpha3(code string) (string, bool) {
+	c, ok := CountryByCode(code)
+	return c.Alpha3, ok
+}
+
+// Converts any code form of a country to its numeric code.
+func Iso3166ToNumeric(code string) (int, bool) {
+	c, ok := CountryByCode(code)
+	return c.Numeric, ok && c.Numeric != 0
+}
+
+// Iso3166 is the validation function for validating if the input is any code form
+// (alpha-2, alpha-3 or numeric) of a country.
+var Iso3166 = inRegistry("Iso3166", func(r Registry, code string) bool {
+	_, ok := r.Country(code)
+	return ok
+})
+
+// The numeric codes that are accepted by Iso3166AlphaNumeric without a country record, since the
+// earlier versions of the package accepted them.
+var legacyNumericCodes = map[int]bool{153: true}
+
+var (
+	countryByAlpha2  = map[string]Country{}
+	countryByAlpha3  = map[string]Country{}
+	countryByNumeric = map[int]Country{}
+)
+
+func init() {
+	for _, c := range countries {
+		countryByAlpha2[c.Alpha2] = c
+		countryByAlpha3[c.Alpha3] = c
+		if c.Numeric != 0 {
+			countryByNumeric[c.Numeric] = c
+		}
+	}
+}
+
+var countries = []Country{
+	// see: https://www.iso.org/iso-3166-country-codes.html
+	{"AF", "AFG", 4, "Afghanistan", false},
+	{"AX", "ALA", 248, "Åland Islands", true},
+	{"AL", "ALB", 8, "Albania", false},
+	{"DZ", "DZA", 12, "Algeria", true},
+	{"AS", "ASM", 16, "American Samoa", true},
+	{"AD", "AND", 20, "Andorra", true},
+	{"AO", "AGO", 24, "Angola", false},
+	{"AI", "AIA", 660, "Anguilla", false},
+	{"AQ", "ATA", 10, "Antarctica", false},
+	{"AG", "ATG", 28, "Antigua and Barbuda", false},
+	{"AR", "ARG", 32, "Argentina", true},
+	{"AM", "ARM", 51, "Armenia", true},
+	{"AW", "ABW", 533, "Aruba", false},
+	{"AU", "AUS", 36, "Australia", true},
+	{"AT", "AUT", 40, "Austria", true},
+	{"AZ", "AZE", 31, "Azerbaijan", true},
+	{"BS", "BHS", 44, "Bahamas", false},
+	{"BH", "BHR", 48, "Bahrain", true},
+	{"BD", "BGD", 50, "Bangladesh", true},
+	{"BB", "BRB", 52, "Barbados", true},
+	{"BY", "BLR", 112, "Belarus", true},
+	{"BE", "BEL", 56, "Belgium", true},
+	{"BZ", "BLZ", 84, "Belize", false},
+	{"BJ", "BEN", 204, "Benin", false},
+	{"BM", "BMU", 60, "Bermuda", true},
+	{"BT", "BTN", 64, "Bhutan", false},
+	{"BO", "BOL", 68, "Bolivia (Plurinational State of)", false},
+	{"BQ", "BES", 535, "Bonaire, Sint Eustatius and Saba", false},
+	{"BA", "BIH", 70, "Bosnia and Herzegovina", true},
+	{"BW", "BWA", 72, "Botswana", false},
+	{"BV", "BVT", 74, "Bouvet Island", false},
+	{"BR", "BRA", 76, "Brazil", true},
+	{"IO", "IOT", 86, "British Indian Ocean Territory", true},
+	{"BN", "BRN", 96, "Brunei Darussalam", true},
+	{"BG", "BGR", 100, "Bulgaria", true},
+	{"BF", "BFA", 854, "Burkina Faso", false},
+	{"BI", "BDI", 108, "Burundi", false},
+	{"CV", "CPV", 132, "Cabo Verde", true},
+	{"KH", "KHM", 116, "Cambodia", true},
+	{"CM", "CMR", 120, "Cameroon", false},
+	{"CA", "CAN", 124, "Canada", true},
+	{"KY", "CYM", 136, "Cayman Islands", false},
+	{"CF", "CAF", 140, "Central African Republic", false},
+	{"TD", "TCD", 148, "Chad", false},
+	{"CL", "CHL", 152, "Chile", true},
+	{"CN", "CHN", 156, "China", true},
+	{"CX", "CXR", 162, "Christmas Island", true},
+	{"CC", "CCK", 166, "Cocos (Keeling) Islands", true},
+	{"CO", "COL", 170, "Colombia", false},
+	{"KM", "COM", 174, "Comoros", false},
+	{"CG", "COG", 178, "Congo", false},
+	{"CD", "COD", 180, "Congo, Democratic Republic of the", false},
+	{"CK", "COK", 184, "Cook Islands", true},
+	{"CR", "CRI", 188, "Costa Rica", true},
+	{"CI", "CIV", 384, "Côte d'Ivoire", false},
+	{"HR", "HRV", 191, "Croatia", true},
+	{"CU", "CUB", 192, "Cuba", false},
+	{"CW", "CUW", 531, "Curaçao", false},
+	{"CY", "CYP", 196, "Cyprus", true},
+	{"CZ", "CZE", 203, "Czechia", true},
+	{"DK", "DNK", 208, "Denmark", true},
+	{"DJ", "DJI", 262, "Djibouti", false},
+	{"DM", "DMA", 212, "Dominica", false},
+	{"DO", "DOM", 214, "Dominican Republic", true},
+	{"EC", "ECU", 218, "Ecuador", true},
+	{"EG", "EGY", 818, "Egypt", true},
+	{"SV", "SLV", 222, "El Salvador", false},
+	{"GQ", "GNQ", 226, "Equatorial Guinea", false},
+	{"ER", "ERI", 232, "Eritrea", false},
+	{"EE", "EST", 233, "Estonia", true},
+	{"SZ", "SWZ", 748, "Eswatini", true},
+	{"ET", "ETH", 231, "Ethiopia", true},
+	{"FK", "FLK", 238, "Falkland Islands (Malvinas)", true},
+	{"FO", "FRO", 234, "Faroe Islands", true},
+	{"FJ", "FJI", 242, "Fiji", false},
+	{"FI", "FIN", 246, "Finland", true},
+	{"FR", "FRA", 250, "France", true},
+	{"GF", "GUF", 254, "French Guiana", true},
+	{"PF", "PYF", 258, "French Polynesia", true},
+	{"TF", "ATF", 260, "French Southern Territories", false},
+	{"GA", "GAB", 266, "Gabon", false},
+	{"GM", "GMB", 270, "Gambia", false},
+	{"GE", "GEO", 268, "Georgia", true},
+	{"DE", "DEU", 276, "Germany", true},
+	{"GH", "GHA", 288, "Ghana", false},
+	{"GI", "GIB", 292, "Gibraltar", false},
+	{"GR", "GRC", 300, "Greece", true},
+	{"GL", "GRL", 304, "Greenland", true},
+	{"GD", "GRD", 308, "Grenada", false},
+	{"GP", "GLP", 312, "Guadeloupe", true},
+	{"GU", "GUM", 316, "Guam", true},
+	{"GT", "GTM", 320, "Guatemala", true},
+	{"GG", "GGY", 831, "Guernsey", true},
+	{"GN", "GIN", 324, "Guinea", true},
+	{"GW", "GNB", 624, "Guinea-Bissau", true},
+	{"GY", "GUY", 328, "Guyana", false},
+	{"HT", "HTI", 332, "Haiti", true},
+	{"HM", "HMD", 334, "Heard Island and McDonald Islands", true},
+	{"VA", "VAT", 336, "Holy See", true},
+	{"HN", "HND", 340, "Honduras", true},
+	{"HK", "HKG", 344, "Hong Kong", false},
+	{"HU", "HUN", 348, "Hungary", true},
+	{"IS", "ISL", 352, "Iceland", true},
+	{"IN", "IND", 356, "India", true},
+	{"ID", "IDN", 360, "Indonesia", true},
+	{"IR", "IRN", 364, "Iran (Islamic Republic of)", false},
+	{"IQ", "IRQ", 368, "Iraq", true},
+	{"IE", "IRL", 372, "Ireland", false},
+	{"IM", "IMN", 833, "Isle of Man", true},
+	{"IL", "ISR", 376, "Israel", true},
+	{"IT", "ITA", 380, "Italy", true},
+	{"JM", "JAM", 388, "Jamaica", false},
+	{"JP", "JPN", 392, "Japan", true},
+	{"JE", "JEY", 832, "Jersey", true},
+	{"JO", "JOR", 400, "Jordan", true},
+	{"KZ", "KAZ", 398, "Kazakhstan", true},
+	{"KE", "KEN", 404, "Kenya", true},
+	{"KI", "KIR", 296, "Kiribati", false},
+	{"KP", "PRK", 408, "Korea (Democratic People's Republic of)", false},
+	{"KR", "KOR", 410, "Korea, Republic of", true},
+	{"KW", "KWT", 414, "Kuwait", true},
+	{"KG", "KGZ", 417, "Kyrgyzstan", true},
+	{"LA", "LAO", 418, "Lao People's Democratic Republic", true},
+	{"LV", "LVA", 428, "Latvia", true},
+	{"LB", "LBN", 422, "Lebanon", true},
+	{"LS", "LSO", 426, "Lesotho", true},
+	{"LR", "LBR", 430, "Liberia", true},
+	{"LY", "LBY", 434, "Libya", false},
+	{"LI", "LIE", 438, "Liechtenstein", true},
+	{"LT", "LTU", 440, "Lithuania", true},
+	{"LU", "LUX", 442, "Luxembourg", true},
+	{"MO", "MAC", 446, "Macao", false},
+	{"MG", "MDG", 450, "Madagascar", true},
+	{"MW", "MWI", 454, "Malawi", false},
+	{"MY", "MYS", 458, "Malaysia", true},
+	{"MV", "MDV", 462, "Maldives", true},
+	{"ML", "MLI", 466, "Mali", false},
+	{"MT", "MLT", 470, "Malta", true},
+	{"MH", "MHL", 584, "Marshall Islands", true},
+	{"MQ", "MTQ", 474, "Martinique", true},
+	{"MR", "MRT", 478, "Mauritania", false},
+	{"MU", "MUS", 480, "Mauritius", true},
+	{"YT", "MYT", 175, "Mayotte", true},
+	{"MX", "MEX", 484, "Mexico", true},
+	{"FM", "FSM", 583, "Micronesia (Federated States of)", true},
+	{"MD", "MDA", 498, "Moldova, Republic of", true},
+	{"MC", "MCO", 492, "Monaco", true},
+	{"MN", "MNG", 496, "Mongolia", true},
+	{"ME", "MNE", 499, "Montenegro", true},
+	{"MS", "MSR", 500, "Montserrat", false},
+	{"MA", "MAR", 504, "Morocco", true},
+	{"MZ", "MOZ", 508, "Mozambique", false},
+	{"MM", "MMR", 104, "Myanmar", false},
+	{"NA", "NAM", 516, "Namibia", false},
+	{"NR", "NRU", 520, "Nauru", false},
+	{"NP", "NPL", 524, "Nepal", true},
+	{"NL", "NLD", 528, "Netherlands, Kingdom of the", true},
+	{"NC", "NCL", 540, "New Caledonia", true},
+	{"NZ", "NZL", 554, "New Zealand", true},
+	{"NI", "NIC", 558, "Nicaragua", true},
+	{"NE", "NER", 562, "Niger", true},
+	{"NG", "NGA", 566, "Nigeria", true},
+	{"NU", "NIU", 570, "Niue", false},
+	{"NF", "NFK", 574, "Norfolk Island", true},
+	{"MK", "MKD", 807, "North Macedonia", true},
+	{"MP", "MNP", 580, "Northern Mariana Islands", true},
+	{"NO", "NOR", 578, "Norway", true},
+	{"OM", "OMN", 512, "Oman", true},
+	{"PK", "PAK", 586, "Pakistan", true},
+	{"PW", "PLW", 585, "Palau", true},
+	{"PS", "PSE", 275, "Palestine, State of", false},
+	{"PA", "PAN", 591, "Panama", false},
+	{"PG", "PNG", 598, "Papua New Guinea", true},
+	{"PY", "PRY", 600, "Paraguay", true},
+	{"PE", "PER", 604, "Peru", false},
+	{"PH", "PHL", 608, "Philippines", true},
+	{"PN", "PCN", 612, "Pitcairn", true},
+	{"PL", "POL", 616, "Poland", true},
+	{"PT", "PRT", 620, "Portugal", true},
+	{"PR", "PRI", 630, "Puerto Rico", true},
+	{"QA", "QAT", 634, "Qatar", false},
+	{"RE", "REU", 638, "Réunion", true},
+	{"RO", "ROU", 642, "Romania", true},
+	{"RU", "RUS", 643, "Russian Federation", true},
+	{"RW", "RWA", 646, "Rwanda", false},
+	{"BL", "BLM", 652, "Saint Barthélemy", false},
+	{"SH", "SHN", 654, "Saint Helena, Ascension and Tristan da Cunha", true},
+	{"KN", "KNA", 659, "Saint Kitts and Nevis", false},
+	{"LC", "LCA", 662, "Saint Lucia", false},
+	{"MF", "MAF", 663, "Saint Martin (French part)", false},
+	{"PM", "SPM", 666, "Saint Pierre and Miquelon", true},
+	{"VC", "VCT", 670, "Saint Vincent and the Grenadines", false},
+	{"WS", "WSM", 882, "Samoa", false},
+	{"SM", "SMR", 674, "San Marino", true},
+	{"ST", "STP", 678, "Sao Tome and Principe", false},
+	{"SA", "SAU", 682, "Saudi Arabia", true},
+	{"SN", "SEN", 686, "Senegal", true},
+	{"RS", "SRB", 688, "Serbia", true},
+	{"SC", "SYC", 690, "Seychelles", false},
+	{"SL", "SLE", 694, "Sierra Leone", false},
+	{"SG", "SGP", 702, "Singapore", true},
+	{"SX", "SXM", 534, "Sint Maarten (Dutch part)", false},
+	{"SK", "SVK", 703, "Slovakia", true},
+	{"SI", "SVN", 705, "Slovenia", true},
+	{"SB", "SLB", 90, "Solomon Islands", false},
+	{"SO", "SOM", 706, "Somalia", true},
+	{"ZA", "ZAF", 710, "South Africa", true},
+	{"GS", "SGS", 239, "South Georgia and the South Sandwich Islands", true},
+	{"SS", "SSD", 728, "South Sudan", false},
+	{"ES", "ESP", 724, "Spain", true},
+	{"LK", "LKA", 144, "Sri Lanka", true},
+	{"SD", "SDN", 729, "Sudan", false},
+	{"SR", "SUR", 740, "Suriname", false},
+	{"SJ", "SJM", 744, "Svalbard and Jan Mayen", true},
+	{"SE", "SWE", 752, "Sweden", true},
+	{"CH", "CHE", 756, "Switzerland", true},
+	{"SY", "SYR", 760, "Syrian Arab Republic", false},
+	{"TW", "TWN", 158, "Taiwan, Province of China", true},
+	{"TJ", "TJK", 762, "Tajikistan", true},
+	{"TZ", "TZA", 834, "Tanzania, United Republic of", false},
+	{"TH", "THA", 764, "Thailand", true},
+	{"TL", "TLS", 626, "Timor-Leste", false},
+	{"TG", "TGO", 768, "Togo", false},
+	{"TK", "TKL", 772, "Tokelau", false},
+	{"TO", "TON", 776, "Tonga", false},
+	{"TT", "TTO", 780, "Trinidad and Tobago", false},
+	{"TN", "TUN", 788, "Tunisia", true},
+	{"TR", "TUR", 792, "Türkiye", true},
+	{"TM", "TKM", 795, "Turkmenistan", true},
+	{"TC", "TCA", 796, "Turks and Caicos Islands", true},
+	{"TV", "TUV", 798, "Tuvalu", false},
+	{"UG", "UGA", 800, "Uganda", false},
+	{"UA", "UKR", 804, "Ukraine", true},
+	{"AE", "ARE", 784, "United Arab Emirates", false},
+	{"GB", "GBR", 826, "United Kingdom of Great Britain and Northern Ireland", true},
+	{"US", "USA", 840, "United States of America", true},
+	{"UM", "UMI", 581, "United States Minor Outlying Islands", false},
+	{"UY", "URY", 858, "Uruguay", true},
+	{"UZ", "UZB", 860, "Uzbekistan", true},
+	{"VU", "VUT", 548, "Vanuatu", false},
+	{"VE", "VEN", 862, "Venezuela (Bolivarian Republic of)", true},
+	{"VN", "VNM", 704, "Viet Nam", true},
+	{"VG", "VGB", 92, "Virgin Islands (British)", false},
+	{"VI", "VIR", 850, "Virgin Islands (U.S.)", true},
+	{"WF", "WLF", 876, "Wallis and Futuna", true},
+	{"EH", "ESH", 732, "Western Sahara", false},
+	{"YE", "YEM", 887, "Yemen", false},
+	{"ZM", "ZMB", 894, "Zambia", true},
+	{"ZW", "ZWE", 716, "Zimbabwe", false},
+	{"XK", "UNK", 0, "Kosovo", true},
+}
@@ -0,0 +1,66 @@
+// Factory functions that decode the base64 or hex encoded input, and validate the decoded
+// payload with a []byte validator, e.g. a 32 bytes long key:
+//
+//	key := fv.Base64Decoded(fv.LenEq[[]byte](32))
+package funcvalid
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"net/http"
+)
+
+// Factory function with a validator parameter that returns a validator, that validates if the
+// input is standard base64 encoded (with padding), and the decoded payload is valid by the parameter.
+func Base64Decoded(validator Validator[[]byte]) Validator[string] {
+	return decoded("Base64Decoded", base64.StdEncoding.DecodeString, validator)
+}
+
+// Factory function with a validator parameter that returns a validator, that validates if the
+// input is standard base64 encoded without padding, and the decoded payload is valid by the parameter.
+func Base64RawDecoded(validator Validator[[]byte]) Validator[string] {
+	return decoded("Base64RawDecoded", base64.RawStdEncoding.DecodeString, validator)
+}
+
+// Factory function with a validator parameter that returns a validator, that validates if the
+// input is base64url encoded (with padding), and the decoded payload is valid by the parameter.
+func Base64URLDecoded(validator Validator[[]byte]) Validator[string] {
+	return decoded("Base64URLDecoded", base64.URLEncoding.DecodeString, validator)
+}
+
+// Factory function with a validator parameter that returns a validator, that validates if the
+// input is base64url encoded without padding, and the decoded payload is valid by the parameter.
+func Base64RawURLDecoded(validator Validator[[]byte]) Validator[string] {
+	return decoded("Base64RawURLDecoded", base64.RawURLEncoding.DecodeString, validator)
+}
+
+// Factory function with a validator parameter that returns a validator, that validates if the
+// input is hex encoded, and the decoded payload is valid by the parameter.
+func HexDecoded(validator Validator[[]byte]) Validator[string] {
+	return decoded("HexDecoded", hex.DecodeString, validator)
+}
+
+// Factory function with a number of media type parameters that returns a validator, that validates if
+// the content of the input is one of the media types. The type is sniffed from the content (see
+// http.DetectContentType), and the parameters may contain wildcard subtypes like "image/*".
+func MIME(types ...string) Validator[[]byte] {
+	return withRule(newRule("MIME", "types", types), func(inp []byte) error {
+		if matchMediaType(types, http.DetectContentType(inp)) {
+			return nil
+		}
+		return ruleError("MIME", "types", types)
+	})
+}
+
+// ImageMIME validates if the content of the input is an image (see MIME).
+var ImageMIME = MIME("image/*")
+
+func decoded(name string, decode func(string) ([]byte, error), validator Validator[[]byte]) Validator[string] {
+	return withRule(compositeRule(name, []Validator[[]byte]{validator}), func(inp string) error {
+		data, err := decode(inp)
+		if err != nil {
+			return ruleError(name)
+		}
+		return validator(data)
+	})
+}
@@ -0,0 +1,221 @@
+// Digest validators that verify a digest given in one of the common forms against the content:
+//
+//   - hex or base64 encoded digest ("9f86d0...", "n4bQgYhMfWWaL+qgxVrQFaO/TxsrC4Is0V1sFbDwCgg=")
+//   - subresource integrity string ("sha384-oqVuAfXRKap7fdgcCY5uykM6+R9GqQ8K/uxy9rx7HNQlGYl1kPzQho1wx4JwY8wC")
+//   - algorithm prefixed hex digest, e.g. OCI digest ("sha256:9f86d0...")
+//   - hex or base58btc encoded multihash ("12209f86d0...", "QmZ5NmGeStdit7tV6gdak1F8FyZhPsfA843YS9f2ywKH6w")
+//
+// The supported algorithms are MD5, SHA-1 and SHA-2 (SHA-224, SHA-256, SHA-384 and SHA-512). RIPEMD-160
+// and Tiger aren't supported, since they aren't implemented by the standard library.
+package funcvalid
+
+import (
+	"bytes"
+	"crypto"
+	_ "crypto/md5"
+	_ "crypto/sha1"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"hash"
+	"io"
+	"math/big"
+	"strings"
+	"sync"
+)
+
+// Digest is a parsed digest.
+type Digest struct {
+	Algorithm string // "md5", "sha1", "sha224", "sha256", "sha384" or "sha512"
+	Sum       []byte
+}
+
+var digestAlgorithms = map[string]crypto.Hash{
+	"md5":    crypto.MD5,
+	"sha1":   crypto.SHA1,
+	"sha224": crypto.SHA224,
+	"sha256": crypto.SHA256,
+	"sha384": crypto.SHA384,
+	"sha512": crypto.SHA512,
+}
+
+// The multihash codes of the algorithms (see https://github.com/multiformats/multicodec).
+var multihashCodes = map[uint64]string{0x11: "sha1", 0x12: "sha256", 0x13: "sha512", 0x20: "sha384", 0xd5: "md5"}
+
+// Parses a digest in any of the supported forms. The algorithm of the bare hex or base64 digests is
+// given by the algo parameter, or if it's empty, it is inferred from the length of the digest.
+// If the algo parameter is not empty, the algorithm of the prefixed forms have to match it. The error
+// is a RuleError with the Digest code and the reason as its description.
+func ParseDigest(s string, algo string) (Digest, error) {
+	algo = normalizeDigestAlgorithm(algo)
+	if algo != "" {
+		if _, ok := digestAlgorithms[algo]; !ok {
+			return Digest{}, ruleError("Digest", "description", "unsupported digest algorithm")
+		}
+	}
+	d, ok := parsePrefixedDigest(s)
+	if !ok {
+		d, ok = parseBareDigest(s, algo)
+	}
+	if !ok || (algo != "" && d.Algorithm != algo) {
+		return Digest{}, ruleError("Digest", "description", "invalid format")
+	}
+	if len(d.Sum) != digestAlgorithms[d.Algorithm].Size() {
+		return Digest{}, ruleError("Digest", "description", "invalid length")
+	}
+	return d, nil
+}
+
+// Reports if the digest is the digest of the data.
+func (d Digest) Matches(data []byte) bool {
+	h := digestAlgorithms[d.Algorithm].New()
+	h.Write(data)
+	return subtle.ConstantTimeCompare(h.Sum(nil), d.Sum) == 1
+}
+
+// Factory function with a data and an algorithm parameter that returns a validator, that validates if
+// the input is a digest of the data (see ParseDigest for the forms and the algo parameter).
+func DigestOf(data []byte, algo string) Validator[string] {
+	return withRule(newRule("DigestOf", "algorithm", algo), func(inp string) error {
+		if d, err := ParseDigest(inp, algo); err == nil && d.Matches(data) {
+			return nil
+		}
+		return ruleError("DigestOf", "algorithm", algo)
+	})
+}
+
+// Factory function with a reader parameter that returns a validator, that validates if the input is a
+// digest of the content of the reader with any of the supported algorithms (see ParseDigest). The
+// reader is consumed at the first validation, and the digests are computed at once in a single pass.
+func DigestMatches(r io.Reader) Validator[string] {
+	var once sync.Once
+	sums := map[string][]byte{}
+	var readErr error
+	return withRule(newRule("DigestMatches"), func(inp string) error {
+		once.Do(func() {
+			hashes := map[string]hash.Hash{}
+			var writers []io.Writer
+			for name, h := range digestAlgorithms {
+				hashes[name] = h.New()
+				writers = append(writers, hashes[name])
+			}
+			if _, readErr = io.Copy(io.MultiWriter(writers...), r); readErr == nil {
+				for name, h := range hashes {
+					sums[name] = h.Sum(nil)
+				}
+			}
+		})
+		if d, err := ParseDigest(inp, ""); readErr == nil && err == nil &&
+			subtle.ConstantTimeCompare(sums[d.Algorithm], d.Sum) == 1 {
+			return nil
+		}
+		return ruleError("DigestMatches")
+	})
+}
+
+func normalizeDigestAlgorithm(algo string) string {
+	// e.g. "SHA-256" and "sha2-256" are normalized to "sha256"
+	algo = strings.ToLower(algo)
+	if rest, ok := strings.CutPrefix(algo, "sha2-"); ok {
+		algo = "sha" + rest
+	}
+	return strings.ReplaceAll(algo, "-", "")
+}
+
+// Parses the forms of the digests that contain the algorithm (SRI, prefixed and multihash).
+func parsePrefixedDigest(s string) (Digest, bool) {
+	for _, algo := range []string{"sha256", "sha384", "sha512"} {
+		if b64, ok := strings.CutPrefix(s, algo+"-"); ok {
+			// the options of an SRI hash expression are ignored
+			b64, _, _ = strings.Cut(b64, "?")
+			sum, err := base64.StdEncoding.DecodeString(b64)
+			return Digest{algo, sum}, err == nil
+		}
+	}
+	if algo, encoded, ok := strings.Cut(s, ":"); ok {
+		sum, err := hex.DecodeString(encoded)
+		if _, known := digestAlgorithms[algo]; !known || err != nil || strings.ToLower(encoded) != encoded {
+			return Digest{}, false
+		}
+		return Digest{algo, sum}, true
+	}
+	if strings.HasPrefix(s, "Qm") {
+		if data, ok := decodeBase58(s); ok {
+			return parseMultihash(data)
+		}
+	}
+	return Digest{}, false
+}
+
+func parseBareDigest(s string, algo string) (Digest, bool) {
+	sum, err := hex.DecodeString(s)
+	isHex := err == nil
+	if !isHex {
+		if sum, err = base64.StdEncoding.DecodeString(s); err != nil {
+			if sum, err = base64.RawURLEncoding.DecodeString(s); err != nil {
+				return Digest{}, false
+			}
+		}
+	}
+	if algo == "" {
+		// the sizes of the supported algorithms are all different (and different from the sizes of
+		// their multihashes), so a bare hex digest that starts like a multihash isn't mistaken for one
+		for name, h := range digestAlgorithms {
+			if h.Size() == len(sum) {
+				return Digest{name, sum}, true
+			}
+		}
+		if isHex {
+			return parseMultihash(sum)
+		}
+		return Digest{}, false
+	}
+	return Digest{algo, sum}, true
+}
+
+func parseMultihash(data []byte) (Digest, bool) {
+	code, n := readUvarint(data)
+	if n <= 0 {
+		return Digest{}, false
+	}
+	length, m := readUvarint(data[n:])
+	if m <= 0 {
+		return Digest{}, false
+	}
+	algo, ok := multihashCodes[code]
+	sum := data[n+m:]
+	if !ok || uint64(len(sum)) != length || len(sum) != digestAlgorithms[algo].Size() {
+		return Digest{}, false
+	}
+	return Digest{algo, sum}, true
+}
+
+func readUvarint(data []byte) (uint64, int) {
+	var x uint64
+	for i, b := range data {
+		if i == 9 {
+			return 0, -1
+		}
+		x |= uint64(b&0x7f) << (7 * i)
+		if b < 0x80 {
+			return x, i + 1
+		}
+	}
+	return 0, 0
+}
+
+const base58Alphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+
+func decodeBase58(s string) ([]byte, bool) {
+	n := new(big.Int)
+	for _, r := range s {
+		i := strings.IndexRune(base58Alphabet, r)
+		if i < 0 {
+			return nil, false
+		}
+		n.Mul(n, big.NewInt(58))
+		n.Add(n, big.NewInt(int64(i)))
+	}
+	zeros := len(s) - len(strings.TrimLeft(s, "1"))
+	return append(bytes.Repeat([]byte{0}, zeros), n.Bytes()...), true
+}
@@ -0,0 +1,150 @@
+// Configurable email validator that parses the address according to RFC 5322 instead of
+// matching the Email regexp.
+package funcvalid
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/mail"
+	"strings"
+)
+
+// MXResolver looks up the MX records of a domain. *net.Resolver implements it, and tests
+// may use a fake implementation.
+type MXResolver interface {
+	LookupMX(ctx context.Context, name string) ([]*net.MX, error)
+}
+
+// EmailOption configures the validator returned by EmailWith.
+type EmailOption func(*emailConfig)
+
+type emailConfig struct {
+	maxLocal      int
+	maxTotal      int
+	noIPLiteral   bool
+	noQuotedLocal bool
+	noPlus        bool
+	noIDN         bool
+	blocked       func(domain string) bool
+	resolver      MXResolver
+}
+
+// Sets the maximum length of the local part and of the whole address (64 and 254 by default).
+func EmailMaxLength(local int, total int) EmailOption {
+	return func(c *emailConfig) {
+		c.maxLocal, c.maxTotal = local, total
+	}
+}
+
+// Rejects the IP literal domains like user@[192.0.2.1].
+func EmailNoIPLiteral() EmailOption {
+	return func(c *emailConfig) {
+		c.noIPLiteral = true
+	}
+}
+
+// Rejects the quoted local parts like "john doe"@example.com.
+func EmailNoQuotedLocal() EmailOption {
+	return func(c *emailConfig) {
+		c.noQuotedLocal = true
+	}
+}
+
+// Rejects the plus-addressing (subaddress) like user+tag@example.com.
+func EmailNoPlusAddressing() EmailOption {
+	return func(c *emailConfig) {
+		c.noPlus = true
+	}
+}
+
+// Rejects the internationalized domain names, that are otherwise accepted and validated
+// in their punycode form.
+func EmailNoIDN() EmailOption {
+	return func(c *emailConfig) {
+		c.noIDN = true
+	}
+}
+
+// Rejects the domains for which the blocked function returns true (e.g. disposable email
+// providers). The function gets the lowercase ASCII form of the domain.
+func EmailDomainBlocklist(blocked func(domain string) bool) EmailOption {
+	return func(c *emailConfig) {
+		c.blocked = blocked
+	}
+}
+
+// Requires the domain to have MX records according to the resolver (e.g. net.DefaultResolver).
+func EmailMXCheck(resolver MXResolver) EmailOption {
+	return func(c *emailConfig) {
+		c.resolver = resolver
+	}
+}
+
+// Factory function with option parameters that returns a validator, that validates if the input
+// is a bare RFC 5322 email address (without display name) that satisfies the options.
+func EmailWith(opts ...EmailOption) Validator[string] {
+	c := emailConfig{maxLocal: 64, maxTotal: 254}
+	for _, opt := range opts {
+		opt(&c)
+	}
+	return withRule(newRule("EmailWith"), func(inp string) error {
+		if err := c.validate(inp); err != nil {
+			return ruleError("EmailWith", "description", err.Error())
+		}
+		return nil
+	})
+}
+
+func (c *emailConfig) validate(inp string) error {
+	addr, err := mail.ParseAddress(inp)
+	if err != nil || addr.Name != "" || inp != strings.TrimSpace(inp) || strings.HasSuffix(inp, ">") {
+		return errors.New("invalid address")
+	}
+	at := strings.LastIndex(inp, "@")
+	local, domain := inp[:at], inp[at+1:]
+	if len(local) > c.maxLocal {
+		return errors.New("local part too long")
+	}
+	quoted := strings.HasPrefix(local, `"`)
+	if quoted && c.noQuotedLocal {
+		return errors.New("quoted local part")
+	}
+	if c.noPlus && strings.Contains(local, "+") {
+		return errors.New("plus-addressing")
+	}
+	if strings.HasPrefix(domain, "[") {
+		if c.noIPLiteral {
+			return errors.New("IP literal domain")
+		}
+		ip := strings.TrimPrefix(strings.Trim(domain, "[]"), "IPv6:")
+		if net.ParseIP(ip) == nil {
+			return errors.New("invalid IP literal domain")
+		}
+		if len(inp) > c.maxTotal {
+			return errors.New("address too long")
+		}
+		return nil
+	}
+	if c.noIDN && !isASCII(domain) {
+		return errors.New("internationalized domain")
+	}
+	domain, err = domainToASCII(domain)
+	if err != nil || len(domain) > 253 || strings.HasSuffix(domain, ".") || !fqdnRegexRFC1123.MatchString(domain) {
+		return errors.New("invalid domain")
+	}
+	if len(local)+1+len(domain) > c.maxTotal {
+		return errors.New("address too long")
+	}
+	if c.blocked != nil && c.blocked(domain) {
+		return errors.New("blocked domain")
+	}
+	if c.resolver != nil {
+		mxs, err := c.resolver.LookupMX(context.Background(), domain)
+		// a single "." record is a null MX (RFC 7505), i.e. the domain doesn't accept mail
+		if err != nil || len(mxs) == 0 || (len(mxs) == 1 && mxs[0].Host == ".") {
+			return errors.New("no MX record")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,141 @@
+// File-system validators built on fs.FS, so they can be used with os.DirFS, embed.FS or
+// fstest.MapFS. The input of the validators is a path in the file system (see fs.ValidPath),
+// e.g. config-supplied paths can be validated relative to a base directory:
+//
+//	configFile := fv.And(fv.FileExists(os.DirFS("/etc/myapp")), fv.FileExtension(".yaml", ".yml"))
+package funcvalid
+
+import (
+	"io"
+	"io/fs"
+	"mime"
+	"net/http"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// Factory function with a file system parameter that returns a validator, that
+// validates if the input is an existing directory.
+func Dir(fsys fs.FS) Validator[string] {
+	return withRule(newRule("Dir"), func(inp string) error {
+		if info, err := fs.Stat(fsys, inp); err == nil && info.IsDir() {
+			return nil
+		}
+		return ruleError("Dir")
+	})
+}
+
+// Factory function with a file system parameter that returns a validator, that
+// validates if the input is an existing file (i.e. not a directory).
+func FileExists(fsys fs.FS) Validator[string] {
+	return withRule(newRule("FileExists"), func(inp string) error {
+		if info, err := fs.Stat(fsys, inp); err == nil && !info.IsDir() {
+			return nil
+		}
+		return ruleError("FileExists")
+	})
+}
+
+// Factory function with a file system parameter that returns a validator, that
+// validates if the input is a file or directory that has read permission and can be opened.
+func Readable(fsys fs.FS) Validator[string] {
+	return withRule(newRule("Readable"), func(inp string) error {
+		if f, err := fsys.Open(inp); err == nil {
+			defer f.Close()
+			if info, err := f.Stat(); err == nil && info.Mode().Perm()&0o444 != 0 {
+				return nil
+			}
+		}
+		return ruleError("Readable")
+	})
+}
+
+// Factory function with a file system parameter that returns a validator, that
+// validates if the input is a file that has execute permission.
+func Executable(fsys fs.FS) Validator[string] {
+	return withRule(newRule("Executable"), func(inp string) error {
+		if info, err := fs.Stat(fsys, inp); err == nil && !info.IsDir() && info.Mode().Perm()&0o111 != 0 {
+			return nil
+		}
+		return ruleError("Executable")
+	})
+}
+
+// Factory function with a file system and a size parameter that returns a validator, that
+// validates if the input is a file not larger than the size in bytes.
+func MaxFileSize(fsys fs.FS, size int64) Validator[string] {
+	return withRule(newRule("MaxFileSize", "size", size), func(inp string) error {
+		if info, err := fs.Stat(fsys, inp); err == nil && !info.IsDir() && info.Size() <= size {
+			return nil
+		}
+		return ruleError("MaxFileSize", "size", size)
+	})
+}
+
+// Factory function with a number of extension parameters (like ".json") that returns a validator,
+// that validates if the input path has one of the extensions (case-insensitive).
+func FileExtension(exts ...string) Validator[string] {
+	return withRule(newRule("FileExtension", "extensions", exts), func(inp string) error {
+		if ext := path.Ext(filepath.ToSlash(inp)); ext != "" && containsFold(exts, ext) {
+			return nil
+		}
+		return ruleError("FileExtension", "extensions", exts)
+	})
+}
+
+// Factory function with a file system and a number of media type parameters that returns a validator,
+// that validates if the content of the input file is one of the media types. The type is sniffed from
+// the first 512 bytes of the content (see http.DetectContentType), and the parameters may contain
+// wildcard subtypes like "image/*".
+func MIMEType(fsys fs.FS, types ...string) Validator[string] {
+	return withRule(newRule("MIMEType", "types", types), func(inp string) error {
+		if f, err := fsys.Open(inp); err == nil {
+			defer f.Close()
+			head := make([]byte, 512)
+			n, err := io.ReadFull(f, head)
+			if err == nil || err == io.EOF || err == io.ErrUnexpectedEOF {
+				if matchMediaType(types, http.DetectContentType(head[:n])) {
+					return nil
+				}
+			}
+		}
+		return ruleError("MIMEType", "types", types)
+	})
+}
+
+// Factory function with a root directory parameter that returns a validator, that validates if
+// the input path (relative to the root or absolute) stays within the root after resolving the
+// ".." elements. Symbolic links are not resolved.
+func PathWithin(root string) Validator[string] {
+	return withRule(newRule("PathWithin", "root", root), func(inp string) error {
+		p := inp
+		if !filepath.IsAbs(p) {
+			p = filepath.Join(root, p)
+		}
+		rel, err := filepath.Rel(filepath.Clean(root), filepath.Clean(p))
+		if err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+			return nil
+		}
+		return ruleError("PathWithin", "root", root)
+	})
+}
+
+// Reports if the media type (possibly with parameters) matches one of the patterns.
+func matchMediaType(patterns []string, mediaType string) bool {
+	mediaType, _, err := mime.ParseMediaType(mediaType)
+	if err != nil {
+		return false
+	}
+	for _, pattern := range patterns {
+		pattern = strings.ToLower(pattern)
+		if prefix, ok := strings.CutSuffix(pattern, "/*"); ok {
+			if strings.HasPrefix(mediaType, prefix+"/") {
+				return true
+			}
+		} else if pattern == mediaType {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,57 @@
+// Validators for JSON documents embedded in strings.
+package funcvalid
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+// JSON is the validation function for validating if the input is a well-formed JSON document.
+func JSON(input string) error {
+	if json.Valid([]byte(input)) {
+		return nil
+	}
+	return ruleError("JSON")
+}
+
+// JSONObject is the validation function for validating if the input is a well-formed JSON object.
+func JSONObject(input string) error {
+	if strings.HasPrefix(strings.TrimLeft(input, " \t\r\n"), "{") && json.Valid([]byte(input)) {
+		return nil
+	}
+	return ruleError("JSONObject")
+}
+
+// JSONArray is the validation function for validating if the input is a well-formed JSON array.
+func JSONArray(input string) error {
+	if strings.HasPrefix(strings.TrimLeft(input, " \t\r\n"), "[") && json.Valid([]byte(input)) {
+		return nil
+	}
+	return ruleError("JSONArray")
+}
+
+// JSONPointer is the validation function for validating if the input is a JSON Pointer as per RFC 6901.
+func JSONPointer(input string) error {
+	if input != "" && input[0] != '/' {
+		return ruleError("JSONPointer")
+	}
+	for i := 0; i < len(input); i++ {
+		if input[i] == '~' && (i+1 == len(input) || (input[i+1] != '0' && input[i+1] != '1')) {
+			return ruleError("JSONPointer")
+		}
+	}
+	return nil
+}
+
+// Factory function with a validator parameter that returns a validator, that validates if the
+// input is a well-formed JSON document, and its decoded value (as decoded by json.Unmarshal into
+// an any) is valid by the parameter validator.
+func JSONMatches(validator Validator[any]) Validator[string] {
+	return withRule(Rule{Name: "JSONMatches", Children: []Rule{RuleOf(validator)}}, func(inp string) error {
+		var value any
+		if err := json.Unmarshal([]byte(inp), &value); err != nil {
+			return ruleError("JSONMatches")
+		}
+		return validator(value)
+	})
+}
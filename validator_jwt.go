@@ -0,0 +1,235 @@
+// Configurable JWT validator that decodes the header and the claims of the token, and optionally
+// verifies its signature using only the standard library.
+package funcvalid
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/hmac"
+	"crypto/rsa"
+	_ "crypto/sha256"
+	_ "crypto/sha512"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"math"
+	"math/big"
+	"strings"
+	"time"
+)
+
+// JWTOption configures the validator returned by JWTWith.
+type JWTOption func(*jwtConfig)
+
+type jwtConfig struct {
+	algorithms []string
+	now        func() time.Time
+	leeway     time.Duration
+	requireExp bool
+	key        any
+}
+
+// Allows only the signing algorithms (the "alg" header). By default any algorithm except "none" is allowed.
+func JWTAlgorithms(algs ...string) JWTOption {
+	return func(c *jwtConfig) {
+		c.algorithms = algs
+	}
+}
+
+// Sets the clock that the time based claims (exp, nbf, iat) are validated against (time.Now by default).
+func JWTClock(now func() time.Time) JWTOption {
+	return func(c *jwtConfig) {
+		c.now = now
+	}
+}
+
+// Sets the tolerance of the time based claims for clock skew.
+func JWTLeeway(leeway time.Duration) JWTOption {
+	return func(c *jwtConfig) {
+		c.leeway = leeway
+	}
+}
+
+// Requires the exp claim.
+func JWTRequireExp() JWTOption {
+	return func(c *jwtConfig) {
+		c.requireExp = true
+	}
+}
+
+// Requires a valid signature by the key: a []byte secret for HS256/384/512, an *rsa.PublicKey
+// for RS256/384/512 and PS256/384/512, an *ecdsa.PublicKey for ES256/384/512 or an
+// ed25519.PublicKey for EdDSA.
+func JWTVerify(key any) JWTOption {
+	return func(c *jwtConfig) {
+		c.key = key
+	}
+}
+
+// Factory function with option parameters that returns a validator, that validates if the input
+// is a JWT in compact serialization with JSON header and claims that satisfy the options.
+func JWTWith(opts ...JWTOption) Validator[string] {
+	c := jwtConfig{now: time.Now}
+	for _, opt := range opts {
+		opt(&c)
+	}
+	return withRule(newRule("JWTWith"), func(inp string) error {
+		if err := c.validate(inp); err != nil {
+			return ruleError("JWTWith", "description", err.Error())
+		}
+		return nil
+	})
+}
+
+func (c *jwtConfig) validate(inp string) error {
+	parts := strings.Split(inp, ".")
+	if len(parts) != 3 {
+		return errors.New("invalid format")
+	}
+	var header struct {
+		Alg string `json:"alg"`
+	}
+	if err := decodeJWTSegment(parts[0], &header); err != nil {
+		return errors.New("invalid header")
+	}
+	var claims map[string]any
+	if err := decodeJWTSegment(parts[1], &claims); err != nil {
+		return errors.New("invalid claims")
+	}
+	if header.Alg == "" || (len(c.algorithms) == 0 && strings.EqualFold(header.Alg, "none")) ||
+		(len(c.algorithms) > 0 && !containsString(c.algorithms, header.Alg)) {
+		return errors.New("algorithm not allowed")
+	}
+	if err := c.validateTimes(claims); err != nil {
+		return err
+	}
+	if c.key != nil {
+		signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+		if err != nil || !verifyJWTSignature(header.Alg, c.key, []byte(parts[0]+"."+parts[1]), signature) {
+			return errors.New("invalid signature")
+		}
+	}
+	return nil
+}
+
+func (c *jwtConfig) validateTimes(claims map[string]any) error {
+	now := c.now()
+	claimTime := func(name string) (time.Time, bool, error) {
+		value, ok := claims[name]
+		if !ok {
+			return time.Time{}, false, nil
+		}
+		n, ok := value.(json.Number)
+		if !ok {
+			return time.Time{}, false, errors.New("invalid " + name)
+		}
+		f, err := n.Float64()
+		if err != nil {
+			return time.Time{}, false, errors.New("invalid " + name)
+		}
+		sec, frac := math.Modf(f)
+		return time.Unix(int64(sec), int64(frac*1e9)), true, nil
+	}
+	exp, ok, err := claimTime("exp")
+	if err != nil {
+		return err
+	}
+	if ok && !now.Before(exp.Add(c.leeway)) {
+		return errors.New("expired")
+	}
+	if !ok && c.requireExp {
+		return errors.New("missing exp")
+	}
+	nbf, ok, err := claimTime("nbf")
+	if err != nil {
+		return err
+	}
+	if ok && now.Before(nbf.Add(-c.leeway)) {
+		return errors.New("not valid yet")
+	}
+	iat, ok, err := claimTime("iat")
+	if err != nil {
+		return err
+	}
+	if ok && iat.After(now.Add(c.leeway)) {
+		return errors.New("issued in the future")
+	}
+	return nil
+}
+
+// Decodes a base64url encoded JSON object of a JWT.
+func decodeJWTSegment(segment string, v any) error {
+	data, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return err
+	}
+	if !bytes.HasPrefix(bytes.TrimSpace(data), []byte("{")) {
+		return errors.New("not an object")
+	}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	return dec.Decode(v)
+}
+
+var (
+	jwtHashes    = map[string]crypto.Hash{"256": crypto.SHA256, "384": crypto.SHA384, "512": crypto.SHA512}
+	jwtCurveBits = map[string]int{"ES256": 256, "ES384": 384, "ES512": 521}
+)
+
+func verifyJWTSignature(alg string, key any, signed []byte, signature []byte) bool {
+	if alg == "EdDSA" {
+		k, ok := key.(ed25519.PublicKey)
+		return ok && ed25519.Verify(k, signed, signature)
+	}
+	if len(alg) != 5 {
+		return false
+	}
+	hash, ok := jwtHashes[alg[2:]]
+	if !ok {
+		return false
+	}
+	h := hash.New()
+	h.Write(signed)
+	digest := h.Sum(nil)
+	switch alg[:2] {
+	case "HS":
+		secret, ok := key.([]byte)
+		if !ok {
+			return false
+		}
+		mac := hmac.New(hash.New, secret)
+		mac.Write(signed)
+		return hmac.Equal(mac.Sum(nil), signature)
+	case "RS":
+		k, ok := key.(*rsa.PublicKey)
+		return ok && rsa.VerifyPKCS1v15(k, hash, digest, signature) == nil
+	case "PS":
+		k, ok := key.(*rsa.PublicKey)
+		return ok && rsa.VerifyPSS(k, hash, digest, signature, nil) == nil
+	case "ES":
+		k, ok := key.(*ecdsa.PublicKey)
+		if !ok {
+			return false
+		}
+		bits := k.Curve.Params().BitSize
+		size := (bits + 7) / 8
+		if bits != jwtCurveBits[alg] || len(signature) != 2*size {
+			return false
+		}
+		r := new(big.Int).SetBytes(signature[:size])
+		s := new(big.Int).SetBytes(signature[size:])
+		return ecdsa.Verify(k, digest, r, s)
+	}
+	return false
+}
+
+func containsString(list []string, s string) bool {
+	for _, e := range list {
+		if e == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,437 @@
+// Password policy validator with character class requirements, strength estimation, forbidden
+// substrings, repeated and sequential character limits and breached password check, e.g.:
+//
+//	password := fv.PasswordPolicy(
+//		fv.PasswordLength(8, 64),
+//		fv.PasswordMinClasses(3),
+//		fv.PasswordMinScore(3),
+//		fv.PasswordNotContaining(req.Username, req.Email))
+package funcvalid
+
+import (
+	"bufio"
+	"crypto/sha1"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math"
+	"math/bits"
+	"net/http"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// CharClass is a character class of the passwords.
+type CharClass int
+
+const (
+	PasswordLower  CharClass = 1 << iota // lowercase letters
+	PasswordUpper                        // uppercase letters
+	PasswordDigit                        // digits
+	PasswordSymbol                       // any other characters
+)
+
+// BreachChecker looks up breached passwords in a k-anonymity way: it gets the first 5 characters of
+// the uppercase hex SHA-1 hash of the password, and returns the remaining 35 characters of the hashes
+// of the breached passwords with that prefix (e.g. like the range API of Have I Been Pwned).
+type BreachChecker interface {
+	BreachedSuffixes(prefix string) (map[string]bool, error)
+}
+
+// PwnedPasswordsChecker is a BreachChecker using the range API of Have I Been Pwned
+// (https://haveibeenpwned.com/API/v3#PwnedPasswords).
+type PwnedPasswordsChecker struct {
+	Client *http.Client // http.DefaultClient if nil
+	URL    string       // "https://api.pwnedpasswords.com/range/" if empty
+}
+
+func (c PwnedPasswordsChecker) BreachedSuffixes(prefix string) (map[string]bool, error) {
+	client, url := c.Client, c.URL
+	if client == nil {
+		client = http.DefaultClient
+	}
+	if url == "" {
+		url = "https://api.pwnedpasswords.com/range/"
+	}
+	resp, err := client.Get(url + prefix)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("error: breached password lookup: %s", resp.Status)
+	}
+	suffixes := map[string]bool{}
+	scanner := bufio.NewScanner(resp.Body)
+	for scanner.Scan() {
+		// the lines are in SUFFIX:COUNT format, padding entries have 0 count
+		suffix, count, _ := strings.Cut(strings.TrimSpace(scanner.Text()), ":")
+		if n, err := strconv.Atoi(count); err == nil && n > 0 {
+			suffixes[strings.ToUpper(suffix)] = true
+		}
+	}
+	return suffixes, scanner.Err()
+}
+
+// PasswordOption configures the validator returned by PasswordPolicy.
+type PasswordOption func(*passwordConfig)
+
+type passwordConfig struct {
+	minLength, maxLength int
+	classes              CharClass
+	minClasses           int
+	minScore             int
+	forbidden            []string
+	maxRepeat            int
+	maxSequence          int
+	breachChecker        BreachChecker
+}
+
+// Sets the minimum and maximum length of the password in characters (0 means no limit).
+func PasswordLength(min int, max int) PasswordOption {
+	return func(c *passwordConfig) {
+		c.minLength, c.maxLength = min, max
+	}
+}
+
+// Requires at least one character of each of the classes.
+func PasswordRequireClasses(classes ...CharClass) PasswordOption {
+	return func(c *passwordConfig) {
+		for _, class := range classes {
+			c.classes |= class
+		}
+	}
+}
+
+// Requires characters of at least n different classes.
+func PasswordMinClasses(n int) PasswordOption {
+	return func(c *passwordConfig) {
+		c.minClasses = n
+	}
+}
+
+// Requires the strength score (see PasswordStrength) to be at least the parameter (0-4).
+func PasswordMinScore(score int) PasswordOption {
+	return func(c *passwordConfig) {
+		c.minScore = score
+	}
+}
+
+// Forbids the password to contain the values (case-insensitive), e.g. the username or the email
+// of the user. The local part of email values is forbidden as well, and values shorter than 3
+// characters are ignored. The values are also used by the strength estimation.
+func PasswordNotContaining(values ...string) PasswordOption {
+	return func(c *passwordConfig) {
+		for _, v := range values {
+			v = strings.ToLower(v)
+			if local, _, ok := strings.Cut(v, "@"); ok && utf8.RuneCountInString(local) >= 3 {
+				c.forbidden = append(c.forbidden, local)
+			}
+			if utf8.RuneCountInString(v) >= 3 {
+				c.forbidden = append(c.forbidden, v)
+			}
+		}
+	}
+}
+
+// Limits the number of the same consecutive characters (e.g. 2 rejects "aaa").
+func PasswordMaxRepeat(n int) PasswordOption {
+	return func(c *passwordConfig) {
+		c.maxRepeat = n
+	}
+}
+
+// Limits the length of the ascending or descending character sequences (e.g. 3 rejects "abcd" and "4321").
+func PasswordMaxSequence(n int) PasswordOption {
+	return func(c *passwordConfig) {
+		c.maxSequence = n
+	}
+}
+
+// Rejects the breached passwords according to the checker. The validation fails if the checker fails.
+func PasswordBreachChecker(checker BreachChecker) PasswordOption {
+	return func(c *passwordConfig) {
+		c.breachChecker = checker
+	}
+}
+
+// Factory function with option parameters that returns a validator, that validates if the input
+// is a password that satisfies the options.
+func PasswordPolicy(opts ...PasswordOption) Validator[string] {
+	var c passwordConfig
+	for _, opt := range opts {
+		opt(&c)
+	}
+	return withRule(c.rule(), func(inp string) error {
+		if err := c.validate(inp); err != nil {
+			return ruleError("PasswordPolicy", "description", err.Error())
+		}
+		return nil
+	})
+}
+
+// Returns the rule of the policy with the non-zero limits as parameters.
+func (c *passwordConfig) rule() Rule {
+	rule := newRule("PasswordPolicy")
+	for _, limit := range []struct {
+		name  string
+		value int
+	}{
+		{"minLength", c.minLength}, {"maxLength", c.maxLength}, {"minClasses", c.minClasses},
+		{"minScore", c.minScore}, {"maxRepeat", c.maxRepeat}, {"maxSequence", c.maxSequence},
+	} {
+		if limit.value != 0 {
+			if rule.Params == nil {
+				rule.Params = map[string]any{}
+			}
+			rule.Params[limit.name] = limit.value
+		}
+	}
+	return rule
+}
+
+func (c *passwordConfig) validate(inp string) error {
+	length := utf8.RuneCountInString(inp)
+	if length < c.minLength || (c.maxLength > 0 && length > c.maxLength) {
+		return errors.New("invalid length")
+	}
+	classes := passwordClasses(inp)
+	if classes&c.classes != c.classes {
+		return errors.New("missing character class")
+	}
+	if bits.OnesCount(uint(classes)) < c.minClasses {
+		return errors.New("too few character classes")
+	}
+	lower := strings.ToLower(inp)
+	for _, f := range c.forbidden {
+		if strings.Contains(lower, f) {
+			return errors.New("contains forbidden value")
+		}
+	}
+	runes := []rune(inp)
+	if c.maxRepeat > 0 && longestRun(runes, 0) > c.maxRepeat {
+		return errors.New("too many repeated characters")
+	}
+	if c.maxSequence > 0 && (longestRun(runes, 1) > c.maxSequence || longestRun(runes, -1) > c.maxSequence) {
+		return errors.New("sequential characters")
+	}
+	if c.minScore > 0 {
+		if score, _ := PasswordStrength(inp, c.forbidden...); score < c.minScore {
+			return errors.New("too weak")
+		}
+	}
+	if c.breachChecker != nil {
+		sum := sha1.Sum([]byte(inp))
+		hash := strings.ToUpper(hex.EncodeToString(sum[:]))
+		suffixes, err := c.breachChecker.BreachedSuffixes(hash[:5])
+		if err != nil {
+			return errors.New("breach check failed")
+		}
+		if suffixes[hash[5:]] {
+			return errors.New("breached password")
+		}
+	}
+	return nil
+}
+
+func passwordClasses(s string) CharClass {
+	var classes CharClass
+	for _, r := range s {
+		switch {
+		case unicode.IsLower(r):
+			classes |= PasswordLower
+		case unicode.IsUpper(r):
+			classes |= PasswordUpper
+		case unicode.IsDigit(r):
+			classes |= PasswordDigit
+		default:
+			classes |= PasswordSymbol
+		}
+	}
+	return classes
+}
+
+// Returns the length of the longest run of characters where each character differs by step from the previous one.
+func longestRun(runes []rune, step rune) int {
+	longest, run := 0, 0
+	for i := range runes {
+		if i > 0 && runes[i]-runes[i-1] == step {
+			run++
+		} else {
+			run = 1
+		}
+		if run > longest {
+			longest = run
+		}
+	}
+	return longest
+}
+
+// Estimates the strength of the password in a zxcvbn-like way: the password is covered with the
+// cheapest sequence of patterns (dictionary words with l33t substitutions, the user inputs, repeats,
+// sequences, keyboard rows and years) and brute-forced characters. It returns the score (0-4) and the
+// entropy in bits (log2 of the estimated number of guesses). Like in zxcvbn, only the first 100
+// characters are matched against the patterns (the matching is cubic in the length), the rest are
+// counted as brute-forced characters.
+func PasswordStrength(password string, userInputs ...string) (int, float64) {
+	runes := []rune(password)
+	rest := 0
+	if len(runes) > maxScoredPasswordLength {
+		runes, rest = runes[:maxScoredPasswordLength], len(runes)-maxScoredPasswordLength
+	}
+	entropy := math.Log2(estimatePasswordGuesses(runes, userInputs)) + float64(rest)*math.Log2(10)
+	for score, limit := range []float64{1e3, 1e6, 1e8, 1e10} {
+		if entropy < math.Log2(limit+5) {
+			return score, entropy
+		}
+	}
+	return 4, entropy
+}
+
+const maxScoredPasswordLength = 100
+
+var passwordRanks = map[string]float64{}
+
+func init() {
+	for _, dict := range passwordDictionaries {
+		for rank, word := range dict {
+			if r, ok := passwordRanks[word]; !ok || float64(rank+1) < r {
+				passwordRanks[word] = float64(rank + 1)
+			}
+		}
+	}
+}
+
+var l33tTable = map[rune]rune{'4': 'a', '@': 'a', '8': 'b', '(': 'c', '3': 'e', '6': 'g', '1': 'i', '!': 'i', '|': 'l', '0': 'o', '$': 's', '5': 's', '7': 't', '+': 't', '2': 'z'}
+
+var keyboardRows = []string{"`1234567890-=", "qwertyuiop[]\\", "asdfghjkl;'", "zxcvbnm,./", "1qaz2wsx3edc4rfv5tgb6yhn7ujm8ik,9ol.0p;/"}
+
+func estimatePasswordGuesses(runes []rune, userInputs []string) float64 {
+	n := len(runes)
+	if n == 0 {
+		return 1
+	}
+	ranks := passwordRanks
+	if len(userInputs) > 0 {
+		ranks = make(map[string]float64, len(passwordRanks)+len(userInputs))
+		for word, rank := range passwordRanks {
+			ranks[word] = rank
+		}
+		for i, input := range userInputs {
+			ranks[strings.ToLower(input)] = float64(i + 1)
+		}
+	}
+	// best[k] is the minimal number of guesses of the first k characters
+	best := make([]float64, n+1)
+	brute := make([]bool, n+1)
+	best[0] = 1
+	for k := 1; k <= n; k++ {
+		best[k] = best[k-1] * 10
+		if !brute[k-1] {
+			best[k] *= 2
+		}
+		brute[k] = true
+		for i := 0; i < k-1; i++ {
+			if g := patternGuesses(runes[i:k], ranks) * 2; g > 0 && best[i]*g < best[k] {
+				best[k], brute[k] = best[i]*g, false
+			}
+		}
+	}
+	return best[n]
+}
+
+// Returns the estimated guesses of the cheapest pattern matching the whole token, or 0 if none matches.
+func patternGuesses(token []rune, ranks map[string]float64) float64 {
+	minGuesses := 0.0
+	update := func(g float64) {
+		g = math.Max(g, 50)
+		if minGuesses == 0 || g < minGuesses {
+			minGuesses = g
+		}
+	}
+	s := string(token)
+	lower := strings.ToLower(s)
+	caseVariations := 1.0
+	if lower != s {
+		caseVariations = 2
+		if upper := strings.ToUpper(s); upper != s && !(unicode.IsUpper(token[0]) && lower[1:] == s[1:]) {
+			caseVariations = math.Pow(2, float64(countUpper(token)))
+		}
+	}
+	reversed := reverseString(lower)
+	for _, candidate := range []struct {
+		word   string
+		factor float64
+	}{{lower, 1}, {reversed, 2}, {unl33t(lower, 'i'), 2}, {unl33t(lower, 'l'), 2}} {
+		if rank, ok := ranks[candidate.word]; ok {
+			update(rank * caseVariations * candidate.factor)
+		}
+	}
+	if len(token) >= 3 {
+		if longestRun(token, 0) == len(token) {
+			update(charsetSize(token[0]) * float64(len(token)))
+		}
+		if longestRun(token, 1) == len(token) || longestRun(token, -1) == len(token) {
+			base := 26.0
+			if strings.ContainsRune("aAzZ019", token[0]) {
+				base = 4
+			} else if unicode.IsDigit(token[0]) {
+				base = 10
+			}
+			update(base * float64(len(token)) * caseVariations)
+		}
+		for _, row := range keyboardRows {
+			if strings.Contains(row, lower) || strings.Contains(row, reversed) {
+				update(10 * float64(len(token)) * caseVariations)
+			}
+		}
+	}
+	if len(token) == 4 {
+		if year, err := strconv.Atoi(s); err == nil && year >= 1900 && year <= 2049 {
+			update(math.Max(math.Abs(float64(year-2020)), 20))
+		}
+	}
+	return minGuesses
+}
+
+func unl33t(s string, one rune) string {
+	return strings.Map(func(r rune) rune {
+		if r == '1' {
+			return one
+		}
+		if sub, ok := l33tTable[r]; ok {
+			return sub
+		}
+		return r
+	}, s)
+}
+
+func countUpper(runes []rune) int {
+	n := 0
+	for _, r := range runes {
+		if unicode.IsUpper(r) {
+			n++
+		}
+	}
+	return n
+}
+
+func charsetSize(r rune) float64 {
+	switch {
+	case unicode.IsDigit(r):
+		return 10
+	case unicode.IsLetter(r):
+		return 26
+	}
+	return 33
+}
+
+func reverseString(s string) string {
+	runes := []rune(s)
+	for i, j := 0, len(runes)-1; i < j; i, j = i+1, j-1 {
+		runes[i], runes[j] = runes[j], runes[i]
+	}
+	return string(runes)
+}
@@ -0,0 +1,57 @@
+// Frequency ranked dictionaries used by the password strength estimation. The lists are short
+// excerpts of the most common passwords, English words and names, the rank of a word is its position.
+package funcvalid
+
+import "strings"
+
+var passwordDictionaries = [][]string{
+	strings.Fields(`
+		123456 password 12345678 qwerty 123456789 12345 1234 111111 1234567 dragon
+		123123 baseball abc123 football monkey letmein shadow master 696969 mustang
+		666666 qwertyuiop 123321 1234567890 superman 654321 1qaz2wsx 7777777
+		qazwsx jordan jennifer 123qwe 121212 killer trustno1 hunter harley zxcvbnm
+		asdfgh buster andrew batman soccer tigger charlie robert sunshine iloveyou
+		ranger hockey computer starwars pepper klaster 112233 zxcvbn
+		freedom princess maggie pass ginger 11111111 131313 love cheese
+		159753 summer chelsea dallas matrix yankees 6969 corvette austin
+		access thunder merlin secret diamond hello hammer 1234qwer silver
+		gfhjkm internet samantha golfer scooter test orange cookie q1w2e3r4t5 maverick
+		sparky phoenix mickey bigdog snoopy guitar whatever chicken camaro mercedes
+		peanut ferrari falcon cowboy welcome samsung steelers smokey dakota
+		arsenal boomer eagles tigers marina nascar booboo gateway yellow porsche
+		monster spider diablo hannah bulldog junior london purple compaq lakers
+		iceman qwer1234 cowboys money banana ncc1701 boston tennis q1w2e3r4
+		coffee scooby 123654 nikita yamaha mother barney brandy chester
+		oliver player forever rangers midnight chicago bigdaddy redsox angel badboy
+		fender jasper slayer rabbit natasha marine wizard marlboro raiders
+		prince casper fishing flower jasmine adidas winter winner
+		gandalf password1 enter ghbdtn vkontakte 1q2w3e4r 1q2w3e 1qazxsw2 admin
+		administrator qwerty123 passw0rd p@ssw0rd welcome1 changeme default root
+	`),
+	strings.Fields(`
+		the you that was for are with his they this have from one had word but not
+		what all were when your can said there use each which she how their will
+		other about out many then them these some her would make like him into time
+		has look two more write see number way could people than first water been
+		call who now find long down day did get come made may part over new sound
+		take only little work know place year live back give most very after thing
+		our just name good sentence man think say great where help through much
+		before line right too mean old any same tell boy follow came want show also
+		around form three small set put end does another well large must big even
+		such because turn here why ask went men read need land different home move
+		try kind hand picture again change off play spell air away animal house
+		point page letter mother answer found study still learn should america world
+		high every near add food between own below country plant last school father
+		keep tree never start city earth eye light thought head under story saw left
+		family friend happy summer winter spring autumn sun moon star love baby
+		music dream heart angel magic secret power freedom hello welcome monkey dragon
+	`),
+	strings.Fields(`
+		james john robert michael william david richard charles joseph thomas
+		christopher daniel paul mark donald george kenneth steven edward brian
+		mary patricia linda barbara elizabeth jennifer maria susan margaret dorothy
+		lisa nancy karen betty helen sandra donna carol ruth sharon michelle laura
+		smith johnson williams brown jones miller davis garcia rodriguez wilson
+		martinez anderson taylor thomas hernandez moore martin jackson thompson white
+	`),
+}
@@ -0,0 +1,248 @@
+// Phone number validators that parse the national and international formats, and validate
+// the numbers against the numbering plans of the regions (see validator_phone_metadata.go).
+package funcvalid
+
+import (
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// The line type of a phone number.
+type PhoneType int
+
+const (
+	PhoneUnknown           PhoneType = iota // the region has no detailed numbering plan
+	PhoneFixedLine                          // fixed line number
+	PhoneMobile                             // mobile number
+	PhoneFixedLineOrMobile                  // the plan of the region doesn't distinguish them (e.g. NANP)
+	PhoneTollFree                           // toll-free number
+)
+
+func (t PhoneType) String() string {
+	switch t {
+	case PhoneFixedLine:
+		return "fixed line"
+	case PhoneMobile:
+		return "mobile"
+	case PhoneFixedLineOrMobile:
+		return "fixed line or mobile"
+	case PhoneTollFree:
+		return "toll-free"
+	}
+	return "unknown"
+}
+
+// Phone is a parsed phone number.
+type Phone struct {
+	CountryCode int       // the calling code, e.g. 36
+	National    string    // the national significant number, e.g. "301234567"
+	Region      string    // ISO 3166-1 alpha-2 code of the region, e.g. "HU"
+	Type        PhoneType // the line type of the number
+}
+
+// Returns the number in E.164 format, e.g. "+36301234567".
+func (p Phone) E164() string {
+	return "+" + strconv.Itoa(p.CountryCode) + p.National
+}
+
+// Returns the country record of the region of the number.
+func (p Phone) Country() (Country, bool) {
+	return CountryByCode(p.Region)
+}
+
+// Parses a phone number given in international ("+36 30 123 4567", "0036301234567") or in national
+// ("06 30 123 4567") format. The default region is the code of the country (in any ISO 3166-1 form)
+// whose national format is expected, it may be empty if only international numbers are accepted.
+// The error is a RuleError with the PhoneNumber code and the reason as its description.
+func ParsePhone(input string, defaultRegion string) (Phone, error) {
+	digits, international, ok := phoneDigits(input)
+	if !ok {
+		return Phone{}, ruleError("PhoneNumber", "description", "invalid format")
+	}
+	region := ""
+	if defaultRegion != "" {
+		c, ok := CountryByCode(defaultRegion)
+		if !ok {
+			return Phone{}, ruleError("PhoneNumber", "description", "invalid default region")
+		}
+		region = c.Alpha2
+	}
+	plan, hasPlan := phonePlans[region]
+	if !international && region != "" {
+		internationalPrefix := "00"
+		if hasPlan {
+			internationalPrefix = plan.internationalPrefix
+		}
+		if strings.HasPrefix(digits, internationalPrefix) {
+			digits = digits[len(internationalPrefix):]
+			international = true
+		}
+	}
+	if international {
+		for l := 1; l <= 3 && l < len(digits) && digits[0] != '0'; l++ {
+			cc, _ := strconv.Atoi(digits[:l])
+			if _, ok := phoneRegionsByCode[cc]; ok {
+				national := digits[l:]
+				return newPhone(cc, national, phoneRegion(cc, national))
+			}
+		}
+		return Phone{}, ruleError("PhoneNumber", "description", "invalid country calling code")
+	}
+	cc, ok := phoneCallingCodes[region]
+	if !ok {
+		return Phone{}, ruleError("PhoneNumber", "description", "missing or invalid default region")
+	}
+	nationalPrefix := "0"
+	if hasPlan {
+		nationalPrefix = plan.nationalPrefix
+	}
+	if nationalPrefix != "" {
+		digits = strings.TrimPrefix(digits, nationalPrefix)
+	}
+	return newPhone(cc, digits, region)
+}
+
+// Parses a phone number (see ParsePhone) and returns it in E.164 format.
+func NormalizePhone(input string, defaultRegion string) (string, error) {
+	p, err := ParsePhone(input, defaultRegion)
+	if err != nil {
+		return "", err
+	}
+	return p.E164(), nil
+}
+
+// Factory function with a region parameter that returns a validator, that validates if the
+// input is a valid phone number in national format of the region or in international format.
+func PhoneNumber(defaultRegion string) Validator[string] {
+	return withRule(newRule("PhoneNumber", "region", defaultRegion), func(inp string) error {
+		if _, err := ParsePhone(inp, defaultRegion); err == nil {
+			return nil
+		}
+		return ruleError("PhoneNumber", "region", defaultRegion)
+	})
+}
+
+// Factory function with a region and line type parameters that returns a validator, that
+// validates if the input is a valid phone number (see PhoneNumber) of one of the types.
+func PhoneNumberOfType(defaultRegion string, types ...PhoneType) Validator[string] {
+	return withRule(newRule("PhoneNumberOfType", "region", defaultRegion, "types", types), func(inp string) error {
+		if p, err := ParsePhone(inp, defaultRegion); err == nil {
+			for _, t := range types {
+				if p.Type == t {
+					return nil
+				}
+			}
+		}
+		return ruleError("PhoneNumberOfType", "region", defaultRegion, "types", types)
+	})
+}
+
+// Strips the formatting characters of the input, and reports if it's in international format.
+func phoneDigits(input string) (string, bool, bool) {
+	input = strings.TrimSpace(input)
+	international := strings.HasPrefix(input, "+")
+	if international {
+		input = input[1:]
+	}
+	var digits strings.Builder
+	for _, r := range input {
+		switch {
+		case '0' <= r && r <= '9':
+			digits.WriteRune(r)
+		case strings.ContainsRune(" -.()/", r):
+		default:
+			return "", false, false
+		}
+	}
+	return digits.String(), international, digits.Len() > 0
+}
+
+// Returns the region of a number with a calling code shared by several regions.
+func phoneRegion(cc int, national string) string {
+	regions := phoneRegionsByCode[cc]
+	if len(regions) == 1 {
+		return regions[0]
+	}
+	if cc == 1 && len(national) >= 3 {
+		if region, ok := nanpAreaCodes[national[:3]]; ok {
+			return region
+		}
+	}
+	for _, region := range regions {
+		for _, prefix := range phoneRegionPrefixes[region] {
+			if strings.HasPrefix(national, prefix) {
+				return region
+			}
+		}
+	}
+	for _, region := range regions {
+		if plan, ok := phoneRegexes[region]; ok && plan.classify(national) != PhoneUnknown {
+			return region
+		}
+	}
+	// the number is checked against the plan of the main region
+	return phoneMainRegion[cc]
+}
+
+func newPhone(cc int, national string, region string) (Phone, error) {
+	if len(national) < 4 || len(strconv.Itoa(cc))+len(national) > 15 {
+		return Phone{}, ruleError("PhoneNumber", "description", "invalid length")
+	}
+	p := Phone{CountryCode: cc, National: national, Region: region}
+	if plan, ok := phoneRegexes[region]; ok {
+		if p.Type = plan.classify(national); p.Type == PhoneUnknown {
+			return Phone{}, ruleError("PhoneNumber", "description", "doesn't match the numbering plan")
+		}
+	}
+	return p, nil
+}
+
+type phonePlanRegexes struct {
+	fixedLine *regexp.Regexp
+	mobile    *regexp.Regexp
+	tollFree  *regexp.Regexp
+}
+
+func (p phonePlanRegexes) classify(national string) PhoneType {
+	fixedLine, mobile := p.fixedLine.MatchString(national), p.mobile.MatchString(national)
+	switch {
+	case p.tollFree.MatchString(national):
+		return PhoneTollFree
+	case fixedLine && mobile:
+		return PhoneFixedLineOrMobile
+	case mobile:
+		return PhoneMobile
+	case fixedLine:
+		return PhoneFixedLine
+	}
+	return PhoneUnknown
+}
+
+var (
+	phoneRegionsByCode = map[int][]string{}
+	phoneRegexes       = map[string]phonePlanRegexes{}
+)
+
+func init() {
+	for region, cc := range phoneCallingCodes {
+		phoneRegionsByCode[cc] = append(phoneRegionsByCode[cc], region)
+	}
+	for cc, regions := range phoneRegionsByCode {
+		// the main region goes first, the others in alphabetical order
+		sort.Slice(regions, func(i, j int) bool {
+			if main := phoneMainRegion[cc]; regions[i] == main || regions[j] == main {
+				return regions[i] == main
+			}
+			return regions[i] < regions[j]
+		})
+	}
+	for region, plan := range phonePlans {
+		phoneRegexes[region] = phonePlanRegexes{
+			fixedLine: regexp.MustCompile(`^(?:` + plan.fixedLine + `)$`),
+			mobile:    regexp.MustCompile(`^(?:` + plan.mobile + `)$`),
+			tollFree:  regexp.MustCompile(`^(?:` + plan.tollFree + `)$`),
+		}
+	}
+}
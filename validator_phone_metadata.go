@@ -0,0 +1,140 @@
+// Numbering plan metadata used by the phone number validators. The calling codes cover every
+// ISO 3166-1 region with a telephone service, while the detailed plans (national significant
+// number patterns by line type) are maintained only for a set of regions. Numbers of the other
+// regions are checked against the E.164 length limits only.
+package funcvalid
+
+// The numbering plan of a region. The patterns are matched against the whole national
+// significant number (without the national prefix).
+type phonePlan struct {
+	nationalPrefix      string
+	internationalPrefix string
+	fixedLine           string
+	mobile              string
+	tollFree            string
+}
+
+// The main region of the calling codes shared by several regions.
+var phoneMainRegion = map[int]string{
+	1: "US", 7: "RU", 39: "IT", 44: "GB", 47: "NO", 61: "AU", 64: "NZ", 212: "MA",
+	262: "RE", 290: "SH", 358: "FI", 500: "FK", 590: "GP", 599: "CW", 672: "NF",
+}
+
+var phoneCallingCodes = map[string]int{
+	// see: https://www.itu.int/pub/T-SP-E.164D
+	"AF": 93, "AX": 358, "AL": 355, "DZ": 213, "AS": 1, "AD": 376,
+	"AO": 244, "AI": 1, "AG": 1, "AR": 54, "AM": 374, "AW": 297,
+	"AU": 61, "AT": 43, "AZ": 994, "BS": 1, "BH": 973, "BD": 880,
+	"BB": 1, "BY": 375, "BE": 32, "BZ": 501, "BJ": 229, "BM": 1,
+	"BT": 975, "BO": 591, "BQ": 599, "BA": 387, "BW": 267, "BR": 55,
+	"IO": 246, "BN": 673, "BG": 359, "BF": 226, "BI": 257, "CV": 238,
+	"KH": 855, "CM": 237, "CA": 1, "KY": 1, "CF": 236, "TD": 235,
+	"CL": 56, "CN": 86, "CX": 61, "CC": 61, "CO": 57, "KM": 269,
+	"CG": 242, "CD": 243, "CK": 682, "CR": 506, "CI": 225, "HR": 385,
+	"CU": 53, "CW": 599, "CY": 357, "CZ": 420, "DK": 45, "DJ": 253,
+	"DM": 1, "DO": 1, "EC": 593, "EG": 20, "SV": 503, "GQ": 240,
+	"ER": 291, "EE": 372, "SZ": 268, "ET": 251, "FK": 500, "FO": 298,
+	"FJ": 679, "FI": 358, "FR": 33, "GF": 594, "PF": 689, "GA": 241,
+	"GM": 220, "GE": 995, "DE": 49, "GH": 233, "GI": 350, "GR": 30,
+	"GL": 299, "GD": 1, "GP": 590, "GU": 1, "GT": 502, "GG": 44,
+	"GN": 224, "GW": 245, "GY": 592, "HT": 509, "VA": 39, "HN": 504,
+	"HK": 852, "HU": 36, "IS": 354, "IN": 91, "ID": 62, "IR": 98,
+	"IQ": 964, "IE": 353, "IM": 44, "IL": 972, "IT": 39, "JM": 1,
+	"JP": 81, "JE": 44, "JO": 962, "KZ": 7, "KE": 254, "KI": 686,
+	"KP": 850, "KR": 82, "KW": 965, "KG": 996, "LA": 856, "LV": 371,
+	"LB": 961, "LS": 266, "LR": 231, "LY": 218, "LI": 423, "LT": 370,
+	"LU": 352, "MO": 853, "MG": 261, "MW": 265, "MY": 60, "MV": 960,
+	"ML": 223, "MT": 356, "MH": 692, "MQ": 596, "MR": 222, "MU": 230,
+	"YT": 262, "MX": 52, "FM": 691, "MD": 373, "MC": 377, "MN": 976,
+	"ME": 382, "MS": 1, "MA": 212, "MZ": 258, "MM": 95, "NA": 264,
+	"NR": 674, "NP": 977, "NL": 31, "NC": 687, "NZ": 64, "NI": 505,
+	"NE": 227, "NG": 234, "NU": 683, "NF": 672, "MK": 389, "MP": 1,
+	"NO": 47, "OM": 968, "PK": 92, "PW": 680, "PS": 970, "PA": 507,
+	"PG": 675, "PY": 595, "PE": 51, "PH": 63, "PL": 48, "PT": 351,
+	"PR": 1, "QA": 974, "RE": 262, "RO": 40, "RU": 7, "RW": 250,
+	"BL": 590, "SH": 290, "KN": 1, "LC": 1, "MF": 590, "PM": 508,
+	"VC": 1, "WS": 685, "SM": 378, "ST": 239, "SA": 966, "SN": 221,
+	"RS": 381, "SC": 248, "SL": 232, "SG": 65, "SX": 1, "SK": 421,
+	"SI": 386, "SB": 677, "SO": 252, "ZA": 27, "SS": 211, "ES": 34,
+	"LK": 94, "SD": 249, "SR": 597, "SJ": 47, "SE": 46, "CH": 41,
+	"SY": 963, "TW": 886, "TJ": 992, "TZ": 255, "TH": 66, "TL": 670,
+	"TG": 228, "TK": 690, "TO": 676, "TT": 1, "TN": 216, "TR": 90,
+	"TM": 993, "TC": 1, "TV": 688, "UG": 256, "UA": 380, "AE": 971,
+	"GB": 44, "US": 1, "UY": 598, "UZ": 998, "VU": 678, "VE": 58,
+	"VN": 84, "VG": 1, "VI": 1, "WF": 681, "EH": 212, "YE": 967,
+	"ZM": 260, "ZW": 263, "XK": 383,
+}
+
+// The area codes of the North American Numbering Plan that don't belong to the US.
+// The leading digits of the national significant numbers of the regions without a detailed plan,
+// that share their calling code with a region with a plan (the NANP regions are resolved by their
+// area codes).
+var phoneRegionPrefixes = map[string][]string{
+	"GG": {"1481", "7781", "7839", "7911"},
+	"IM": {"1624", "7524", "7624", "7924"},
+	"JE": {"1534", "7509", "7700", "7797", "7829", "7937"},
+	"CC": {"89162"},
+	"CX": {"89164"},
+	"VA": {"06698"},
+	"SJ": {"79"},
+	"EH": {"5288", "5289"},
+	"YT": {"269", "639"},
+	"AX": {"18"},
+	"BQ": {"7"},
+}
+
+var nanpAreaCodes = map[string]string{
+	"204": "CA", "226": "CA", "236": "CA", "249": "CA", "250": "CA", "263": "CA",
+	"289": "CA", "306": "CA", "343": "CA", "354": "CA", "365": "CA", "367": "CA",
+	"368": "CA", "382": "CA", "387": "CA", "403": "CA", "416": "CA", "418": "CA",
+	"428": "CA", "431": "CA", "437": "CA", "438": "CA", "450": "CA", "460": "CA",
+	"468": "CA", "474": "CA", "506": "CA", "514": "CA", "519": "CA", "548": "CA",
+	"579": "CA", "581": "CA", "584": "CA", "587": "CA", "604": "CA", "613": "CA",
+	"639": "CA", "647": "CA", "672": "CA", "683": "CA", "705": "CA", "709": "CA",
+	"742": "CA", "753": "CA", "778": "CA", "780": "CA", "782": "CA", "807": "CA",
+	"819": "CA", "825": "CA", "867": "CA", "873": "CA", "879": "CA", "902": "CA",
+	"905": "CA",
+	"242": "BS", "246": "BB", "264": "AI", "268": "AG", "284": "VG", "340": "VI",
+	"345": "KY", "441": "BM", "473": "GD", "649": "TC", "658": "JM", "876": "JM",
+	"664": "MS", "670": "MP", "671": "GU", "684": "AS", "721": "SX", "758": "LC",
+	"767": "DM", "784": "VC", "787": "PR", "939": "PR", "809": "DO", "829": "DO",
+	"849": "DO", "868": "TT", "869": "KN",
+}
+
+var nanpPlan = phonePlan{
+	nationalPrefix:      "1",
+	internationalPrefix: "011",
+	fixedLine:           `[2-9]\d{2}[2-9]\d{6}`,
+	mobile:              `[2-9]\d{2}[2-9]\d{6}`,
+	tollFree:            `8(?:00|33|44|55|66|77|88)[2-9]\d{6}`,
+}
+
+var phonePlans = map[string]phonePlan{
+	"US": nanpPlan,
+	"CA": nanpPlan,
+	"AT": {"0", "00", `(?:1\d{3,12}|2\d{4,11}|[3-9]\d{4,10})`, `6(?:5[0-3579]|6[013-9]|[7-9]\d)\d{4,10}`, `800\d{6,10}`},
+	"AU": {"0", "0011", `[2378]\d{8}`, `4\d{8}`, `180(?:0\d{3}|2)\d{3}`},
+	"BE": {"0", "00", `[1-35-9]\d{7}`, `4[5-9]\d{7}`, `800\d{5}`},
+	"BR": {"0", "00", `[1-9]{2}[2-5]\d{7}`, `[1-9]{2}9\d{8}`, `800\d{6,7}`},
+	"CH": {"0", "00", `(?:2[12467]|3[1-4]|4[134]|5[256]|6[12]|[7-9]1)\d{7}`, `7[35-9]\d{7}`, `800\d{6}`},
+	"CN": {"0", "00", `(?:10|2\d|[3-9]\d{2})[2-8]\d{6,7}`, `1[3-9]\d{9}`, `(?:10)?800\d{7}`},
+	"CZ": {"", "00", `[2-5]\d{8}`, `(?:60[1-8]|7[2-9]\d)\d{6}`, `800\d{6}`},
+	"DE": {"0", "00", `[2-9]\d{4,13}`, `1(?:5[0-25-9]\d{8}|6[023]\d{7,8}|7\d{8,9})`, `800\d{7,12}`},
+	"ES": {"", "00", `[89][1-8]\d{7}`, `[67]\d{8}`, `[89]00\d{6}`},
+	"FR": {"0", "00", `[1-5]\d{8}`, `[67]\d{8}`, `80[0-5]\d{6}`},
+	"GB": {"0", "00", `(?:1\d{8,9}|2\d{9}|3\d{9})`, `7(?:[1-57-9]\d{2}|624)\d{6}`, `80(?:0\d{6,7}|8\d{7})`},
+	"HU": {"06", "00", `(?:1\d|[27][2-9]|3[2-7]|4[24-9]|5[2-79]|6[23689]|8[2-57-9]|9[2-69])\d{6}`, `(?:[257]0|3[01])\d{7}`, `80\d{6}`},
+	"IE": {"0", "00", `(?:1\d{7,8}|[2-79]\d{6,8})`, `8[35-9]\d{7}`, `1800\d{6}`},
+	"IN": {"0", "00", `[1-5]\d{9}`, `[6-9]\d{9}`, `1800\d{6,7}`},
+	"IT": {"", "00", `0\d{5,10}`, `3\d{8,9}`, `80(?:0\d{3}|3)\d{3}`},
+	"JP": {"0", "010", `[1-9]\d{8}`, `[7-9]0\d{8}`, `(?:120\d{6}|800\d{7})`},
+	"KZ": {"8", "810", `(?:33622|7[12]\d{3})\d{5}`, `7(?:0[0-25-8]|47|6[0-4]|7[15-8]|85)\d{7}`, `8(?:00|08)\d{7}`},
+	"MX": {"", "00", `[1-9]\d{9}`, `[1-9]\d{9}`, `800\d{7}`},
+	"NL": {"0", "00", `(?:1[0-8]|2[0-46-9]|3[0-8]|4[0-36-9]|5\d|7[0-38])\d{7}`, `6[1-58]\d{7}`, `800\d{4,7}`},
+	"PL": {"", "00", `(?:1[2-8]|2[2-69]|3[2-4]|4[1-468]|5[24-689]|6[1-3578]|7[14-7]|8[1-79]|9[145])\d{7}`, `(?:45|5[0137]|6[069]|7[2389]|88)\d{7}`, `800\d{6}`},
+	"RO": {"0", "00", `[23]\d{8}`, `7\d{8}`, `800\d{6}`},
+	"RU": {"8", "810", `[348]\d{9}`, `9\d{9}`, `80[04]\d{7}`},
+	"SE": {"0", "00", `[1-689]\d{6,8}`, `7[02369]\d{7}`, `20\d{4,7}`},
+	"SK": {"0", "00", `[2-5]\d{7,8}`, `9\d{8}`, `800\d{6}`},
+	"UA": {"0", "00", `[3-6]\d{8}`, `(?:39|50|6[36-8]|7[1-3]|9[1-9])\d{7}`, `800\d{6}`},
+}
@@ -0,0 +1,128 @@
+// String content validators for the common rules that would need handwritten regexps otherwise.
+// Their errors are RuleErrors carrying the parameters of the rules.
+package funcvalid
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Factory function with a prefix parameter that returns a validator, that validates if the input
+// starts with the prefix.
+func HasPrefix(prefix string) Validator[string] {
+	return withRule(newRule("HasPrefix", "prefix", prefix), func(inp string) error {
+		if strings.HasPrefix(inp, prefix) {
+			return nil
+		}
+		return ruleError("HasPrefix", "prefix", prefix)
+	})
+}
+
+// Factory function with a suffix parameter that returns a validator, that validates if the input
+// ends with the suffix.
+func HasSuffix(suffix string) Validator[string] {
+	return withRule(newRule("HasSuffix", "suffix", suffix), func(inp string) error {
+		if strings.HasSuffix(inp, suffix) {
+			return nil
+		}
+		return ruleError("HasSuffix", "suffix", suffix)
+	})
+}
+
+// Factory function with a substring parameter that returns a validator, that validates if the input
+// contains the substring.
+func Contains(substr string) Validator[string] {
+	return withRule(newRule("Contains", "substr", substr), func(inp string) error {
+		if strings.Contains(inp, substr) {
+			return nil
+		}
+		return ruleError("Contains", "substr", substr)
+	})
+}
+
+// Factory function with a characters parameter that returns a validator, that validates if the input
+// contains any of the characters.
+func ContainsAny(chars string) Validator[string] {
+	return withRule(newRule("ContainsAny", "chars", chars), func(inp string) error {
+		if strings.ContainsAny(inp, chars) {
+			return nil
+		}
+		return ruleError("ContainsAny", "chars", chars)
+	})
+}
+
+// Factory function with a substring parameter that returns a validator, that validates if the input
+// doesn't contain the substring.
+func Excludes(substr string) Validator[string] {
+	return withRule(newRule("Excludes", "substr", substr), func(inp string) error {
+		if !strings.Contains(inp, substr) {
+			return nil
+		}
+		return ruleError("Excludes", "substr", substr)
+	})
+}
+
+// Lowercase is the validation function for validating if the input has no uppercase (or titlecase) letters.
+func Lowercase(input string) error {
+	if input == strings.ToLower(input) {
+		return nil
+	}
+	return ruleError("Lowercase")
+}
+
+// Uppercase is the validation function for validating if the input has no lowercase (or titlecase) letters.
+func Uppercase(input string) error {
+	if input == strings.ToUpper(input) {
+		return nil
+	}
+	return ruleError("Uppercase")
+}
+
+// Trimmed is the validation function for validating if the input has no leading or trailing whitespace.
+func Trimmed(input string) error {
+	if input == strings.TrimSpace(input) {
+		return nil
+	}
+	return ruleError("Trimmed")
+}
+
+// NoWhitespace is the validation function for validating if the input has no whitespace characters.
+func NoWhitespace(input string) error {
+	if strings.IndexFunc(input, unicode.IsSpace) < 0 {
+		return nil
+	}
+	return ruleError("NoWhitespace")
+}
+
+// SingleLine is the validation function for validating if the input has no line breaks (LF, CR,
+// vertical tab, form feed, NEL, line and paragraph separators).
+func SingleLine(input string) error {
+	if !strings.ContainsAny(input, "\n\r\v\f\u0085\u2028\u2029") {
+		return nil
+	}
+	return ruleError("SingleLine")
+}
+
+// Factory function with a parameter that returns a validator, that validates if the input equals
+// to the parameter case-insensitively (under Unicode case folding).
+func EqFold(pattern string) Validator[string] {
+	return withRule(newRule("EqFold", "value", pattern), func(inp string) error {
+		if strings.EqualFold(inp, pattern) {
+			return nil
+		}
+		return ruleError("EqFold", "value", pattern)
+	})
+}
+
+// Factory function with a number of parameters that returns a validator, that validates if the input
+// equals to any of the parameters case-insensitively (under Unicode case folding).
+func OneOfFold(elems ...string) Validator[string] {
+	return withRule(newRule("OneOfFold", "values", elems), func(inp string) error {
+		for _, e := range elems {
+			if strings.EqualFold(inp, e) {
+				return nil
+			}
+		}
+		return ruleError("OneOfFold", "values", elems)
+	})
+}
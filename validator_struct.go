@@ -0,0 +1,122 @@
+// Struct validator built from the validators of the fields, e.g.:
+//
+//	type LoginReqData struct {
+//		Username string
+//		Password string
+//	}
+//
+//	validateLogin := fv.Struct(
+//		fv.Field("username", func(l LoginReqData) string { return l.Username }, fv.LenBw[string](1, 30)),
+//		fv.Field("password", func(l LoginReqData) string { return l.Password }, fv.LenBw[string](7, 32)))
+//
+// Unlike AnyErr, it reports the errors of all the invalid fields, and its rule (see RuleOf) describes
+// the fields, e.g. for JSONSchema.
+package funcvalid
+
+import (
+	"reflect"
+)
+
+// StructField is the validator of a field of the S struct type (see Field and OptionalField).
+type StructField[S any] struct {
+	name     string
+	validate func(s S) error
+	rule     Rule
+}
+
+// FieldError is the error of a field of a struct.
+type FieldError struct {
+	Field string
+	Err   error
+}
+
+func (e *FieldError) Error() string {
+	return e.Field + ": " + e.Err.Error()
+}
+
+func (e *FieldError) Unwrap() error {
+	return e.Err
+}
+
+// Returns the validator of a required field with the name, that validates the value returned by
+// the get function with the validator.
+func Field[S any, F any](name string, get func(s S) F, validator Validator[F]) StructField[S] {
+	return structField(name, get, validator, true)
+}
+
+// Returns the validator of an optional field with the name, that validates the value returned by
+// the get function with the validator, unless it's the zero value of its type.
+func OptionalField[S any, F any](name string, get func(s S) F, validator Validator[F]) StructField[S] {
+	return structField(name, get, validator, false)
+}
+
+func structField[S any, F any](name string, get func(s S) F, validator Validator[F], required bool) StructField[S] {
+	return StructField[S]{
+		name: name,
+		validate: func(s S) error {
+			value := get(s)
+			if !required && reflect.ValueOf(&value).Elem().IsZero() {
+				return nil
+			}
+			return validator(value)
+		},
+		rule: Rule{
+			Name:     "Field",
+			Params:   map[string]any{"name": name, "required": required, "type": jsonTypeOf(typeFor[F]())},
+			Children: []Rule{RuleOf(validator)},
+		},
+	}
+}
+
+// Factory function that takes variable number of field validators, and returns a validator that
+// validates if all the fields of the input struct are valid. The error lists the FieldErrors of
+// all the invalid fields.
+func Struct[S any](fields ...StructField[S]) Validator[S] {
+	rule := Rule{Name: "Struct"}
+	for _, f := range fields {
+		rule.Children = append(rule.Children, f.rule)
+	}
+	return withRule(rule, func(inp S) error {
+		var errs []error
+		for _, f := range fields {
+			if err := f.validate(inp); err != nil {
+				errs = append(errs, &FieldError{Field: f.name, Err: err})
+			}
+		}
+		if len(errs) > 0 {
+			return &RuleError{Code: "Struct", Errs: errs}
+		}
+		return nil
+	})
+}
+
+// Returns the reflect.Type of T (like reflect.TypeFor of Go 1.22).
+func typeFor[T any]() reflect.Type {
+	return reflect.TypeOf((*T)(nil)).Elem()
+}
+
+// Returns the JSON type of the Go type ("string", "integer", "number", "boolean", "array" or
+// "object"), or an empty string if it's unknown.
+func jsonTypeOf(t reflect.Type) string {
+	switch t.Kind() {
+	case reflect.String:
+		return "string"
+	case reflect.Bool:
+		return "boolean"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return "integer"
+	case reflect.Float32, reflect.Float64:
+		return "number"
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return "string" // []byte is encoded as a base64 string by encoding/json
+		}
+		return "array"
+	case reflect.Map, reflect.Struct:
+		return "object"
+	case reflect.Pointer:
+		return jsonTypeOf(t.Elem())
+	}
+	return ""
+}
@@ -0,0 +1,466 @@
+// Bridge for the validation tags of the [validator] package, so the tagged structs can be migrated
+// to funcvalid field by field:
+//
+//	type User struct {
+//		Name string `validate:"required,min=1,max=30"`
+//	}
+//
+//	validateName, err := fv.FromTag[string]("required,min=1,max=30")
+//
+// The supported tags are required, omitempty, dive, min, max, len, eq, ne, gt, gte, lt, lte, oneof,
+// the string tags contains, containsany, excludes, startswith, endswith and postcode_iso3166_alpha2,
+// and the built-in validators of the package by their tag names (e.g. email, uuid4, iso3166_1_alpha2),
+// that can be combined with the "|" operator. The tags that refer to other fields (e.g. eqfield) are
+// not supported.
+//
+// [validator]: https://github.com/go-playground/validator
+package funcvalid
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// The built-in string validators by their tag names.
+var tagValidators = map[string]Validator[string]{
+	"alpha":             Alpha,
+	"alphanum":          AlphaNumeric,
+	"alphaunicode":      AlphaUnicode,
+	"alphanumunicode":   AlphaUnicodeNumeric,
+	"numeric":           Numeric,
+	"number":            Number,
+	"hexadecimal":       Hexadecimal,
+	"hexcolor":          HexColor,
+	"rgb":               Rgb,
+	"rgba":              Rgba,
+	"hsl":               Hsl,
+	"hsla":              Hsla,
+	"e164":              E164,
+	"email":             Email,
+	"base64":            Base64,
+	"base64url":         Base64URL,
+	"base64rawurl":      Base64RawURL,
+	"isbn10":            ISBN10,
+	"isbn13":            ISBN13,
+	"uuid3":             UUID3,
+	"uuid4":             UUID4,
+	"uuid5":             UUID5,
+	"uuid":              UUID,
+	"uuid3_rfc4122":     UUID3RFC4122,
+	"uuid4_rfc4122":     UUID4RFC4122,
+	"uuid5_rfc4122":     UUID5RFC4122,
+	"uuid_rfc4122":      UUIDRFC4122,
+	"ulid":              ULID,
+	"md4":               Md4,
+	"md5":               Md5,
+	"sha256":            Sha256,
+	"sha384":            Sha384,
+	"sha512":            Sha512,
+	"ripemd128":         Ripemd128,
+	"ripemd160":         Ripemd160,
+	"tiger128":          Tiger128,
+	"tiger160":          Tiger160,
+	"tiger192":          Tiger192,
+	"ascii":             ASCII,
+	"printascii":        PrintableASCII,
+	"multibyte":         Multibyte,
+	"datauri":           DataURI,
+	"latitude":          Latitude,
+	"longitude":         Longitude,
+	"ssn":               SSN,
+	"hostname":          HostnameRFC952,
+	"hostname_rfc1123":  HostnameRFC1123,
+	"fqdn":              FqdnRFC1123,
+	"btc_addr":          BtcAddress,
+	"btc_addr_bech32":   Or(BtcLowerAddressBech32, BtcUpperAddressBech32),
+	"eth_addr":          EthAddress,
+	"url_encoded":       URLEncoded,
+	"html_encoded":      HTMLEncoded,
+	"html":              HTML,
+	"jwt":               JWT,
+	"bic":               Bic,
+	"semver":            Semver,
+	"dns_rfc1035_label": DnsRFC1035Label,
+	"cve":               Cve,
+	"mongodb":           Mongodb,
+	"url":               Url,
+	"http_url":          HttpUrl,
+	"uri":               URI,
+	"urn_rfc2141":       UrnRFC2141,
+	"file":              File,
+	"ip":                Ip,
+	"ipv4":              Ipv4,
+	"ipv6":              Ipv6,
+	"json":              JSON,
+	"lowercase":         Lowercase,
+	"uppercase":         Uppercase,
+	"cron":              Cron,
+	"iso3166_1_alpha2":  Iso3166Alpha2,
+	"iso3166_1_alpha3":  Iso3166Alpha3,
+	"iso3166_2":         Iso3166_2,
+	"iso4217":           Iso4217,
+}
+
+// The built-in int validators by their tag names.
+var tagIntValidators = map[string]Validator[int]{
+	"iso3166_1_alpha_numeric": Iso3166AlphaNumeric,
+	"iso4217_numeric":         Iso4217Numeric,
+}
+
+// Parses the validation tag of the validator package, and returns the validator of the T type that
+// checks the rules of the tag. The rules are checked in order, and the error is the error of the first
+// failed rule. (The min, max, len, gt, gte, lt and lte tags check the length of the strings, slices and
+// maps, the length of the strings is the number of their runes.)
+func FromTag[T any](tag string) (Validator[T], error) {
+	v, err := tagValidator(typeFor[T](), strings.Split(tag, ","))
+	if err != nil {
+		return nil, err
+	}
+	return withRule(RuleOf(v), func(inp T) error {
+		return v(reflect.ValueOf(&inp).Elem())
+	}), nil
+}
+
+// Returns the validator of the values of the type that checks the rules of the comma-separated tags.
+func tagValidator(t reflect.Type, tags []string) (Validator[reflect.Value], error) {
+	var validators []Validator[reflect.Value]
+	for i, tag := range tags {
+		switch tag {
+		case "":
+			continue
+		case "omitempty":
+			rest, err := tagValidator(t, tags[i+1:])
+			if err != nil {
+				return nil, err
+			}
+			validators = append(validators, omitEmpty(rest))
+			return andValue(validators), nil
+		case "dive":
+			v, err := diveValidator(t, tags[i+1:])
+			if err != nil {
+				return nil, err
+			}
+			validators = append(validators, v)
+			return andValue(validators), nil
+		}
+		var alternatives []Validator[reflect.Value]
+		for _, alternative := range strings.Split(tag, "|") {
+			name, param, _ := strings.Cut(alternative, "=")
+			v, err := tagRule(t, name, unescapeTagParam(param))
+			if err != nil {
+				return nil, err
+			}
+			alternatives = append(alternatives, v)
+		}
+		if len(alternatives) == 1 {
+			validators = append(validators, alternatives[0])
+		} else {
+			validators = append(validators, Or(alternatives...))
+		}
+	}
+	return andValue(validators), nil
+}
+
+func andValue(validators []Validator[reflect.Value]) Validator[reflect.Value] {
+	if len(validators) == 1 {
+		return validators[0]
+	}
+	return And(validators...)
+}
+
+// Returns a validator that skips the zero values, and validates the others with the validator.
+func omitEmpty(validator Validator[reflect.Value]) Validator[reflect.Value] {
+	return withRule(Rule{Name: "OmitEmpty", Children: []Rule{RuleOf(validator)}}, func(inp reflect.Value) error {
+		if inp.IsZero() {
+			return nil
+		}
+		return validator(inp)
+	})
+}
+
+// Returns a validator that validates the elements of the slices, arrays or maps by the tags.
+func diveValidator(t reflect.Type, tags []string) (Validator[reflect.Value], error) {
+	base := indirectType(t)
+	switch base.Kind() {
+	case reflect.Slice, reflect.Array, reflect.Map:
+	default:
+		return nil, fmt.Errorf("error: FromTag: dive is not applicable to %s", t)
+	}
+	elem, err := tagValidator(base.Elem(), tags)
+	if err != nil {
+		return nil, err
+	}
+	rule := Rule{
+		Name:     "Each",
+		Params:   map[string]any{"type": jsonTypeOf(base.Elem())},
+		Children: []Rule{RuleOf(elem)},
+	}
+	return withRule(rule, func(inp reflect.Value) error {
+		v, ok := indirect(inp)
+		if !ok {
+			return nil
+		}
+		if v.Kind() == reflect.Map {
+			iter := v.MapRange()
+			for iter.Next() {
+				if err := elem(iter.Value()); err != nil {
+					return &RuleError{Code: "Each", Params: map[string]any{"key": iter.Key().Interface()}, Errs: []error{err}}
+				}
+			}
+			return nil
+		}
+		for i := 0; i < v.Len(); i++ {
+			if err := elem(v.Index(i)); err != nil {
+				return &RuleError{Code: "Each", Params: map[string]any{IndexParam: i}, Errs: []error{err}}
+			}
+		}
+		return nil
+	}), nil
+}
+
+// Returns the validator of the values of the type for the tag with the parameter.
+func tagRule(t reflect.Type, name string, param string) (Validator[reflect.Value], error) {
+	base := indirectType(t)
+	notApplicable := fmt.Errorf("error: FromTag: %s is not applicable to %s", name, t)
+	invalidParam := fmt.Errorf("error: FromTag: invalid %s parameter %q", name, param)
+	switch name {
+	case "required":
+		return withRule(newRule("Required"), func(inp reflect.Value) error {
+			if inp.IsZero() {
+				return ruleError("Required")
+			}
+			return nil
+		}), nil
+	case "min", "max", "len", "eq", "ne", "gt", "gte", "lt", "lte":
+		return comparisonTag(t, name, param)
+	case "oneof":
+		values := splitOneOf(param)
+		if base.Kind() == reflect.String {
+			return valueRule("OneOf", "values", values, func(inp reflect.Value) bool {
+				for _, value := range values {
+					if inp.String() == value {
+						return true
+					}
+				}
+				return false
+			}), nil
+		}
+		params := make([]any, len(values))
+		compares := make([]func(inp reflect.Value) int, len(values))
+		for i, value := range values {
+			var err error
+			if params[i], compares[i], err = parseTagNumber(base.Kind(), value); err == errNotNumber {
+				return nil, notApplicable
+			} else if err != nil {
+				return nil, invalidParam
+			}
+		}
+		return valueRule("OneOf", "values", params, func(inp reflect.Value) bool {
+			for _, compare := range compares {
+				if compare(inp) == 0 {
+					return true
+				}
+			}
+			return false
+		}), nil
+	}
+	var v Validator[string]
+	switch name {
+	case "contains":
+		v = Contains(param)
+	case "containsany":
+		v = ContainsAny(param)
+	case "excludes":
+		v = Excludes(param)
+	case "startswith":
+		v = HasPrefix(param)
+	case "endswith":
+		v = HasSuffix(param)
+	case "postcode_iso3166_alpha2":
+		if _, ok := CountryByCode(param); !ok {
+			return nil, invalidParam
+		}
+		v = PostCodeByIso3166(param)
+	default:
+		if iv, ok := tagIntValidators[name]; ok {
+			if base.Kind() < reflect.Int || base.Kind() > reflect.Uint64 {
+				return nil, notApplicable
+			}
+			return withRule(RuleOf(iv), func(inp reflect.Value) error {
+				inp, ok := indirect(inp)
+				if !ok {
+					return ruleError("Required")
+				}
+				if inp.CanInt() {
+					return iv(int(inp.Int()))
+				}
+				return iv(int(inp.Uint()))
+			}), nil
+		}
+		var ok bool
+		if v, ok = tagValidators[name]; !ok {
+			return nil, fmt.Errorf("error: FromTag: unknown tag %q", name)
+		}
+	}
+	if base.Kind() != reflect.String {
+		return nil, notApplicable
+	}
+	return withRule(RuleOf(v), func(inp reflect.Value) error {
+		inp, ok := indirect(inp)
+		if !ok {
+			return ruleError("Required")
+		}
+		return v(inp.String())
+	}), nil
+}
+
+// The checks of the comparison tags by the result of the comparison of the input to the parameter.
+var tagComparisons = map[string]func(c int) bool{
+	"min": func(c int) bool { return c >= 0 },
+	"gte": func(c int) bool { return c >= 0 },
+	"max": func(c int) bool { return c <= 0 },
+	"lte": func(c int) bool { return c <= 0 },
+	"gt":  func(c int) bool { return c > 0 },
+	"lt":  func(c int) bool { return c < 0 },
+	"len": func(c int) bool { return c == 0 },
+	"eq":  func(c int) bool { return c == 0 },
+	"ne":  func(c int) bool { return c == 0 }, // negated by Not
+}
+
+// Returns the validator of the comparison tag, that compares the numbers to the parameter, the length
+// of the strings (in runes), slices, arrays and maps to the parameter, or checks the equality of the
+// strings and bools with the eq and ne tags.
+func comparisonTag(t reflect.Type, name string, param string) (Validator[reflect.Value], error) {
+	base := indirectType(t)
+	code := map[string]string{"min": "Minimum", "gte": "Minimum", "max": "Maximum", "lte": "Maximum", "gt": "Gt", "lt": "Lt"}[name]
+	if code == "" {
+		code = "Eq"
+	}
+	key := "value"
+	var value any
+	var compare func(inp reflect.Value) int
+	var err error
+	switch kind := base.Kind(); {
+	case kind == reflect.String && (name == "eq" || name == "ne"):
+		value, compare = param, func(inp reflect.Value) int { return strings.Compare(inp.String(), param) }
+	case kind == reflect.Bool && (name == "eq" || name == "ne"):
+		var b bool
+		b, err = strconv.ParseBool(param)
+		value, compare = b, func(inp reflect.Value) int {
+			if inp.Bool() == b {
+				return 0
+			}
+			return 1
+		}
+	case kind == reflect.String || kind == reflect.Slice || kind == reflect.Array || kind == reflect.Map:
+		var length int
+		if length, err = strconv.Atoi(param); length < 0 {
+			err = strconv.ErrRange
+		}
+		code = map[string]string{"min": "MinItems", "gte": "MinItems", "max": "MaxItems", "lte": "MaxItems", "gt": "LenGt", "lt": "LenLt"}[name]
+		if code == "" {
+			code = "LenEq"
+		} else if kind == reflect.String {
+			code = strings.Replace(code, "Items", "Length", 1)
+		}
+		key, value, compare = "length", length, func(inp reflect.Value) int {
+			if inp.Kind() == reflect.String {
+				return cmp.Compare(utf8.RuneCountInString(inp.String()), length)
+			}
+			return cmp.Compare(inp.Len(), length)
+		}
+	default:
+		value, compare, err = parseTagNumber(kind, param)
+	}
+	if err == errNotNumber {
+		return nil, fmt.Errorf("error: FromTag: %s is not applicable to %s", name, t)
+	} else if err != nil {
+		return nil, fmt.Errorf("error: FromTag: invalid %s parameter %q", name, param)
+	}
+	valid := tagComparisons[name]
+	v := valueRule(code, key, value, func(inp reflect.Value) bool { return valid(compare(inp)) })
+	if name == "ne" {
+		return Not(v), nil
+	}
+	return v, nil
+}
+
+// Returns the validator with the rule of the code and the parameter, that checks the (indirected)
+// inputs with the valid function.
+func valueRule(code string, key string, value any, valid func(inp reflect.Value) bool) Validator[reflect.Value] {
+	return withRule(newRule(code, key, value), func(inp reflect.Value) error {
+		inp, ok := indirect(inp)
+		if !ok {
+			return ruleError("Required")
+		}
+		if !valid(inp) {
+			return ruleError(code, key, value)
+		}
+		return nil
+	})
+}
+
+var errNotNumber = errors.New("error: FromTag: not a number")
+
+// Parses the number parameter for the kind, and returns it with a function that compares the
+// (indirected) inputs to it.
+func parseTagNumber(kind reflect.Kind, param string) (any, func(inp reflect.Value) int, error) {
+	switch kind {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(param, 10, 64)
+		return n, func(inp reflect.Value) int { return cmp.Compare(inp.Int(), n) }, err
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		n, err := strconv.ParseUint(param, 10, 64)
+		return n, func(inp reflect.Value) int { return cmp.Compare(inp.Uint(), n) }, err
+	case reflect.Float32, reflect.Float64:
+		n, err := strconv.ParseFloat(param, 64)
+		return n, func(inp reflect.Value) int { return cmp.Compare(inp.Float(), n) }, err
+	}
+	return nil, nil, errNotNumber
+}
+
+// Splits the parameter of the oneof tag by spaces, keeping the 'quoted values' together.
+func splitOneOf(param string) []string {
+	var values []string
+	for param = strings.TrimSpace(param); param != ""; param = strings.TrimSpace(param) {
+		if param[0] == '\'' {
+			if end := strings.IndexByte(param[1:], '\''); end >= 0 {
+				values = append(values, param[1:end+1])
+				param = param[end+2:]
+				continue
+			}
+		}
+		value, rest, _ := strings.Cut(param, " ")
+		values = append(values, value)
+		param = rest
+	}
+	return values
+}
+
+// Replaces the escaped commas (0x2C) and pipes (0x7C) of the parameter.
+func unescapeTagParam(param string) string {
+	return strings.NewReplacer("0x2C", ",", "0x7C", "|").Replace(param)
+}
+
+func indirectType(t reflect.Type) reflect.Type {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	return t
+}
+
+// Returns the value that the pointers point to, or false if any of them is nil.
+func indirect(v reflect.Value) (reflect.Value, bool) {
+	for v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return v, false
+		}
+		v = v.Elem()
+	}
+	return v, true
+}
@@ -0,0 +1,348 @@
+// Unicode-aware string validators: normalization forms, scripts, confusable (homoglyph) detection,
+// invisible and bidirectional control characters and grapheme cluster lengths, e.g. for usernames:
+//
+//	username := fv.And(
+//		fv.NFKC,
+//		fv.NoControlChars,
+//		fv.NoBidiOverride,
+//		fv.NoMixedScripts,
+//		fv.GraphemeLenBw(3, 20),
+//		fv.NotConfusable(usernameSkeletonTaken))
+package funcvalid
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// NFC is the validation function for validating if the input is in Unicode Normalization Form C.
+func NFC(input string) error {
+	if norm.NFC.IsNormalString(input) {
+		return nil
+	}
+	return ruleError("NFC")
+}
+
+// NFKC is the validation function for validating if the input is in Unicode Normalization Form KC.
+func NFKC(input string) error {
+	if norm.NFKC.IsNormalString(input) {
+		return nil
+	}
+	return ruleError("NFKC")
+}
+
+// Factory function with a number of script parameters (e.g. unicode.Latin) that returns a validator,
+// that validates if the characters of the input belong to any of the scripts, or to the common or
+// inherited script (digits, punctuation, combining marks, etc.).
+func Script(scripts ...*unicode.RangeTable) Validator[string] {
+	// the parameters are copied, since appending to them could overwrite the array of the caller
+	all := append(append([]*unicode.RangeTable(nil), scripts...), unicode.Common, unicode.Inherited)
+	rule := scriptRule(all)
+	return withRule(rule, func(inp string) error {
+		for _, r := range inp {
+			if !unicode.In(r, all...) {
+				return ruleError("Script", "scripts", rule.Params["scripts"])
+			}
+		}
+		return nil
+	})
+}
+
+func scriptRule(scripts []*unicode.RangeTable) Rule {
+	var names []string
+	for _, script := range scripts {
+		for name, table := range unicode.Scripts {
+			if table == script && script != unicode.Common && script != unicode.Inherited {
+				names = append(names, name)
+			}
+		}
+	}
+	return newRule("Script", "scripts", names)
+}
+
+// The script names in the order of lookup, the most common scripts are looked up first.
+var scriptNames = func() []string {
+	names := make([]string, 0, len(unicode.Scripts))
+	for name := range unicode.Scripts {
+		names = append(names, name)
+	}
+	common := map[string]int{"Common": 1, "Latin": 2, "Inherited": 3, "Cyrillic": 4, "Greek": 5, "Han": 6}
+	sort.Slice(names, func(i, j int) bool {
+		ci, cj := common[names[i]], common[names[j]]
+		if ci != 0 && cj != 0 {
+			return ci < cj
+		}
+		if ci != 0 || cj != 0 {
+			return ci != 0
+		}
+		return names[i] < names[j]
+	})
+	return names
+}()
+
+func scriptOf(r rune) string {
+	for _, name := range scriptNames {
+		if unicode.Is(unicode.Scripts[name], r) {
+			return name
+		}
+	}
+	return ""
+}
+
+// The script combinations allowed by the highly restrictive level of UTS #39.
+var scriptCombinations = []map[string]bool{
+	{"Latin": true, "Han": true, "Hiragana": true, "Katakana": true},
+	{"Latin": true, "Han": true, "Bopomofo": true},
+	{"Latin": true, "Han": true, "Hangul": true},
+}
+
+// NoMixedScripts is the validation function for validating if the characters of the input belong
+// to a single script (besides the common and inherited ones), or to one of the Latin + Japanese,
+// Latin + Chinese or Latin + Korean combinations (the highly restrictive level of UTS #39).
+// It detects the spoofing with lookalike characters of other scripts, e.g. a Cyrillic "а" in "pаypal".
+func NoMixedScripts(input string) error {
+	scripts := map[string]bool{}
+	for _, r := range input {
+		if script := scriptOf(r); script != "Common" && script != "Inherited" {
+			scripts[script] = true
+		}
+	}
+	if len(scripts) <= 1 {
+		return nil
+	}
+	for _, allowed := range scriptCombinations {
+		covered := true
+		for script := range scripts {
+			covered = covered && allowed[script]
+		}
+		if covered {
+			return nil
+		}
+	}
+	return ruleError("NoMixedScripts")
+}
+
+// The characters that look like Latin letters or digits, mapped to the lowercase Latin letters
+// (a subset of the confusables data of UTS #39). The capital I-like letters are mapped before
+// lowercasing, since they look like "l" (unlike their lowercase forms).
+var confusables = map[rune]string{
+	// Latin, digits and symbols
+	'0': "o", '1': "l", 'I': "l", '|': "l", '\u0131': "i", '\u0251': "a", '\u0261': "g",
+	// Cyrillic
+	'а': "a", 'б': "6", 'г': "r", 'е': "e", 'о': "o", 'п': "n", 'р': "p", 'с': "c", 'у': "y", 'х': "x",
+	'ь': "b", 'ѕ': "s", 'і': "i", 'ј': "j", 'һ': "h", 'ԁ': "d", 'ԛ': "q", 'ԝ': "w", 'ӏ': "l", 'ү': "y",
+	'І': "l", 'Ӏ': "l",
+	// Greek
+	'α': "a", 'γ': "y", 'η': "n", 'ι': "i", 'ν': "v", 'ο': "o", 'ρ': "p", 'υ': "u", 'χ': "x", 'ϲ': "c",
+	'ϳ': "j", 'Ι': "l",
+	// Armenian
+	'գ': "q", 'զ': "q", 'հ': "h", 'ո': "n", 'ս': "u", 'ց': "g", 'օ': "o",
+}
+
+// Returns the skeleton of the string, that is the same for the strings that look alike (similarly to
+// UTS #39, but case-insensitive): the string is decomposed (NFD), the invisible characters and the
+// combining marks are removed, the fullwidth forms and the lookalike characters are mapped to
+// lowercase ASCII, the other characters are lowercased, and the "rn" and "vv" sequences are mapped
+// to "m" and "w".
+func Skeleton(s string) string {
+	var b strings.Builder
+	for _, r := range norm.NFD.String(s) {
+		switch {
+		case unicode.In(r, unicode.Cf, unicode.Mn, unicode.Me):
+		case r >= 0xff01 && r <= 0xff5e:
+			b.WriteString(Skeleton(string(r - 0xff01 + '!')))
+		case confusables[r] != "":
+			b.WriteString(confusables[r])
+		case confusables[unicode.ToLower(r)] != "":
+			b.WriteString(confusables[unicode.ToLower(r)])
+		default:
+			b.WriteRune(unicode.ToLower(r))
+		}
+	}
+	return strings.NewReplacer("rn", "m", "vv", "w").Replace(b.String())
+}
+
+// Reports if the strings look alike, i.e. their skeletons are the same (see Skeleton).
+func Confusable(a string, b string) bool {
+	return Skeleton(a) == Skeleton(b)
+}
+
+// Factory function with a lookup function parameter that returns a validator, that validates if the
+// input isn't confusable with any of the existing values (e.g. usernames). The lookup function gets
+// the skeleton of the input (see Skeleton), and reports if an existing value has the same skeleton.
+func NotConfusable(taken func(skeleton string) bool) Validator[string] {
+	return withRule(newRule("NotConfusable"), func(inp string) error {
+		if taken(Skeleton(inp)) {
+			return ruleError("NotConfusable")
+		}
+		return nil
+	})
+}
+
+// NoControlChars is the validation function for validating if the input doesn't contain control
+// characters (including tab and newline), and invisible formatting characters other than the zero
+// width (non-)joiners that are needed by some scripts and emoji sequences.
+func NoControlChars(input string) error {
+	for _, r := range input {
+		if unicode.IsControl(r) || (unicode.Is(unicode.Cf, r) && r != '\u200c' && r != '\u200d') {
+			return ruleError("NoControlChars")
+		}
+	}
+	return nil
+}
+
+// NoBidiOverride is the validation function for validating if the input doesn't contain the
+// bidirectional embedding, override and isolate characters (U+202A-U+202E, U+2066-U+2069) that
+// can reorder the displayed text.
+func NoBidiOverride(input string) error {
+	for _, r := range input {
+		if (r >= '\u202a' && r <= '\u202e') || (r >= '\u2066' && r <= '\u2069') {
+			return ruleError("NoBidiOverride")
+		}
+	}
+	return nil
+}
+
+const (
+	hangulSBase  = 0xac00
+	hangulTCount = 28
+	hangulSCount = 19 * 21 * hangulTCount // the number of the precomposed Hangul syllables
+)
+
+// Returns the number of the user-perceived characters (extended grapheme clusters) of the string.
+// The clusters are segmented by the rules of UAX #29, except the prepend and the Indic conjunct rules.
+func GraphemeCount(s string) int {
+	count := 0
+	prev := graphemeOther
+	regionalIndicators := 0
+	pictographicZWJ := false // an extended pictographic, extends and a ZWJ precedes
+	pictographic := false    // an extended pictographic and extends precede
+	for _, r := range s {
+		class := graphemeClassOf(r)
+		if count == 0 || graphemeBreak(prev, class, regionalIndicators, pictographicZWJ) {
+			count++
+			regionalIndicators = 0
+		}
+		switch {
+		case class == graphemeRegionalIndicator:
+			regionalIndicators++
+		case class == graphemePictographic:
+			pictographic = true
+		}
+		pictographicZWJ = pictographic && r == '\u200d'
+		if class != graphemeExtend && class != graphemePictographic {
+			pictographic = false
+		}
+		prev = class
+	}
+	return count
+}
+
+type graphemeClass int
+
+const (
+	graphemeOther graphemeClass = iota
+	graphemeCR
+	graphemeLF
+	graphemeControl
+	graphemeExtend
+	graphemeSpacingMark
+	graphemeRegionalIndicator
+	graphemeL
+	graphemeV
+	graphemeT
+	graphemeLV
+	graphemeLVT
+	graphemePictographic
+)
+
+// The extended pictographic characters (mostly emoji).
+var extendedPictographic = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{0x00a9, 0x00ae, 5}, {0x203c, 0x2049, 13}, {0x2122, 0x2139, 23}, {0x2194, 0x21aa, 1},
+		{0x231a, 0x23ff, 1}, {0x24c2, 0x24c2, 1}, {0x25aa, 0x25fe, 1}, {0x2600, 0x27bf, 1},
+		{0x2934, 0x2935, 1}, {0x2b05, 0x2b55, 1}, {0x3030, 0x303d, 13}, {0x3297, 0x3299, 2},
+	},
+	R32: []unicode.Range32{
+		{0x1f000, 0x1f0ff, 1}, {0x1f10d, 0x1f10f, 1}, {0x1f12f, 0x1f12f, 1}, {0x1f16c, 0x1f171, 1},
+		{0x1f17e, 0x1f17f, 1}, {0x1f18e, 0x1f18e, 1}, {0x1f191, 0x1f19a, 1}, {0x1f1ad, 0x1f1e5, 1},
+		{0x1f201, 0x1f20f, 1}, {0x1f21a, 0x1f21a, 1}, {0x1f22f, 0x1f22f, 1}, {0x1f232, 0x1f23a, 1},
+		{0x1f23c, 0x1f23f, 1}, {0x1f249, 0x1f3fa, 1}, {0x1f400, 0x1f53d, 1}, {0x1f546, 0x1f64f, 1},
+		{0x1f680, 0x1f6ff, 1}, {0x1f774, 0x1f77f, 1}, {0x1f7d5, 0x1f7ff, 1}, {0x1f80c, 0x1f80f, 1},
+		{0x1f848, 0x1f84f, 1}, {0x1f85a, 0x1f85f, 1}, {0x1f888, 0x1f88f, 1}, {0x1f8ae, 0x1f8ff, 1},
+		{0x1f90c, 0x1f93a, 1}, {0x1f93c, 0x1f945, 1}, {0x1f947, 0x1faff, 1}, {0x1fc00, 0x1fffd, 1},
+	},
+}
+
+func graphemeClassOf(r rune) graphemeClass {
+	switch {
+	case r == '\r':
+		return graphemeCR
+	case r == '\n':
+		return graphemeLF
+	case r == '\u200c' || r == '\u200d' || (r >= 0x1f3fb && r <= 0x1f3ff) || (r >= 0xe0020 && r <= 0xe007f):
+		return graphemeExtend
+	case unicode.In(r, unicode.Cc, unicode.Cf, unicode.Zl, unicode.Zp):
+		return graphemeControl
+	case unicode.In(r, unicode.Mn, unicode.Me):
+		return graphemeExtend
+	case unicode.Is(unicode.Mc, r):
+		return graphemeSpacingMark
+	case r >= 0x1f1e6 && r <= 0x1f1ff:
+		return graphemeRegionalIndicator
+	case (r >= 0x1100 && r <= 0x115f) || (r >= 0xa960 && r <= 0xa97c):
+		return graphemeL
+	case (r >= 0x1160 && r <= 0x11a7) || (r >= 0xd7b0 && r <= 0xd7c6):
+		return graphemeV
+	case (r >= 0x11a8 && r <= 0x11ff) || (r >= 0xd7cb && r <= 0xd7fb):
+		return graphemeT
+	case r >= hangulSBase && r < hangulSBase+hangulSCount:
+		if (r-hangulSBase)%hangulTCount == 0 {
+			return graphemeLV
+		}
+		return graphemeLVT
+	case unicode.Is(extendedPictographic, r):
+		return graphemePictographic
+	}
+	return graphemeOther
+}
+
+// Reports if there is a grapheme cluster boundary between the characters of the classes.
+func graphemeBreak(prev, next graphemeClass, regionalIndicators int, pictographicZWJ bool) bool {
+	switch {
+	case prev == graphemeCR && next == graphemeLF: // GB3
+		return false
+	case prev == graphemeCR || prev == graphemeLF || prev == graphemeControl: // GB4
+		return true
+	case next == graphemeCR || next == graphemeLF || next == graphemeControl: // GB5
+		return true
+	case prev == graphemeL && (next == graphemeL || next == graphemeV || next == graphemeLV || next == graphemeLVT): // GB6
+		return false
+	case (prev == graphemeLV || prev == graphemeV) && (next == graphemeV || next == graphemeT): // GB7
+		return false
+	case (prev == graphemeLVT || prev == graphemeT) && next == graphemeT: // GB8
+		return false
+	case next == graphemeExtend || next == graphemeSpacingMark: // GB9, GB9a
+		return false
+	case pictographicZWJ && next == graphemePictographic: // GB11
+		return false
+	case prev == graphemeRegionalIndicator && next == graphemeRegionalIndicator: // GB12, GB13
+		return regionalIndicators%2 == 0
+	}
+	return true // GB999
+}
+
+// Factory function with a minimum and a maximum parameter that returns a validator, that validates if
+// the number of the user-perceived characters (see GraphemeCount) of the input is between the parameters
+// (inclusive).
+func GraphemeLenBw(min int, max int) Validator[string] {
+	return withRule(newRule("GraphemeLenBw", "min", min, "max", max), func(inp string) error {
+		if n := GraphemeCount(inp); n < min || n > max {
+			return ruleError("GraphemeLenBw", "min", min, "max", max)
+		}
+		return nil
+	})
+}
@@ -0,0 +1,312 @@
+// Configurable URL validator with scheme, host and component policies, e.g. for the URLs
+// registered as webhooks:
+//
+//	webhook := fv.URLWith(
+//		fv.URLSchemes("https"),
+//		fv.URLNoUserinfo(),
+//		fv.URLNoPrivateHost(),
+//		fv.URLMaxLength(2048))
+package funcvalid
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/netip"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// HostResolver looks up the IP addresses of a host. *net.Resolver implements it, and tests
+// may use a fake implementation.
+type HostResolver interface {
+	LookupIPAddr(ctx context.Context, host string) ([]net.IPAddr, error)
+}
+
+// URLOption configures the validator returned by URLWith.
+type URLOption func(*urlConfig)
+
+type urlConfig struct {
+	schemes         []string
+	requireUserinfo bool
+	noUserinfo      bool
+	allowHosts      []string
+	denyHosts       []string
+	ports           []int
+	noPrivateHost   bool
+	resolver        HostResolver
+	maxLength       int
+	pathPrefix      string
+}
+
+// Allows only the schemes (case-insensitive).
+func URLSchemes(schemes ...string) URLOption {
+	return func(c *urlConfig) {
+		c.schemes = schemes
+	}
+}
+
+// Requires the userinfo (user:password@) component.
+func URLRequireUserinfo() URLOption {
+	return func(c *urlConfig) {
+		c.requireUserinfo = true
+	}
+}
+
+// Forbids the userinfo (user:password@) component.
+func URLNoUserinfo() URLOption {
+	return func(c *urlConfig) {
+		c.noUserinfo = true
+	}
+}
+
+// Allows only the hosts matching one of the patterns. A pattern is either a host name or IP,
+// or a wildcard domain like "*.example.com" that matches all the subdomains of example.com.
+func URLAllowHosts(patterns ...string) URLOption {
+	return func(c *urlConfig) {
+		c.allowHosts = patterns
+	}
+}
+
+// Forbids the hosts matching one of the patterns (see URLAllowHosts).
+func URLDenyHosts(patterns ...string) URLOption {
+	return func(c *urlConfig) {
+		c.denyHosts = patterns
+	}
+}
+
+// Allows only the ports. URLs without explicit port are checked with the default port of the scheme.
+func URLPorts(ports ...int) URLOption {
+	return func(c *urlConfig) {
+		c.ports = ports
+	}
+}
+
+// Forbids the hosts that are loopback, private, link-local, unspecified or multicast IP addresses
+// or "localhost" (SSRF protection). Host names are resolved only if URLResolver is given as well.
+func URLNoPrivateHost() URLOption {
+	return func(c *urlConfig) {
+		c.noPrivateHost = true
+	}
+}
+
+// Sets the resolver (e.g. net.DefaultResolver) that URLNoPrivateHost uses to check the IP addresses
+// of the host names.
+func URLResolver(resolver HostResolver) URLOption {
+	return func(c *urlConfig) {
+		c.resolver = resolver
+	}
+}
+
+// Limits the length of the URL.
+func URLMaxLength(length int) URLOption {
+	return func(c *urlConfig) {
+		c.maxLength = length
+	}
+}
+
+// Requires the path of the URL to start with the prefix. The paths with dot segments ("." or "..",
+// also percent-encoded) are rejected, since they may leave the prefix once resolved.
+func URLPathPrefix(prefix string) URLOption {
+	return func(c *urlConfig) {
+		c.pathPrefix = prefix
+	}
+}
+
+// Factory function with option parameters that returns a validator, that validates if the input
+// is an absolute URL with a host that satisfies the options.
+func URLWith(opts ...URLOption) Validator[string] {
+	var c urlConfig
+	for _, opt := range opts {
+		opt(&c)
+	}
+	return withRule(c.rule(), func(inp string) error {
+		if err := c.validate(inp); err != nil {
+			return ruleError("URLWith", "description", err.Error())
+		}
+		return nil
+	})
+}
+
+// Returns the rule of the validator with the schemes, ports, maximum length and path prefix as parameters.
+func (c *urlConfig) rule() Rule {
+	var keyValues []any
+	if len(c.schemes) > 0 {
+		keyValues = append(keyValues, "schemes", c.schemes)
+	}
+	if len(c.ports) > 0 {
+		keyValues = append(keyValues, "ports", c.ports)
+	}
+	if c.maxLength > 0 {
+		keyValues = append(keyValues, "maxLength", c.maxLength)
+	}
+	if c.pathPrefix != "" {
+		keyValues = append(keyValues, "pathPrefix", c.pathPrefix)
+	}
+	return newRule("URLWith", keyValues...)
+}
+
+var defaultPorts = map[string]int{"http": 80, "https": 443, "ws": 80, "wss": 443, "ftp": 21}
+
+func (c *urlConfig) validate(inp string) error {
+	if c.maxLength > 0 && len(inp) > c.maxLength {
+		return errors.New("too long")
+	}
+	u, err := url.Parse(inp)
+	if err != nil || u.Scheme == "" || u.Hostname() == "" {
+		return errors.New("invalid URL")
+	}
+	scheme := strings.ToLower(u.Scheme)
+	if len(c.schemes) > 0 && !containsFold(c.schemes, scheme) {
+		return errors.New("scheme not allowed")
+	}
+	if c.requireUserinfo && u.User == nil {
+		return errors.New("missing userinfo")
+	}
+	if c.noUserinfo && u.User != nil {
+		return errors.New("userinfo not allowed")
+	}
+	host, err := domainToASCII(strings.TrimSuffix(u.Hostname(), "."))
+	if err != nil {
+		return errors.New("invalid host")
+	}
+	if len(c.allowHosts) > 0 && !matchAnyHost(c.allowHosts, host) {
+		return errors.New("host not allowed")
+	}
+	if matchAnyHost(c.denyHosts, host) {
+		return errors.New("host denied")
+	}
+	if len(c.ports) > 0 {
+		port, ok := defaultPorts[scheme]
+		if u.Port() != "" {
+			port, err = strconv.Atoi(u.Port())
+			ok = err == nil
+		}
+		if !ok || !containsInt(c.ports, port) {
+			return errors.New("port not allowed")
+		}
+	}
+	if c.noPrivateHost {
+		if err := c.checkPublicHost(host); err != nil {
+			return err
+		}
+	}
+	if c.pathPrefix != "" {
+		for _, segment := range strings.Split(u.Path, "/") {
+			if segment == "." || segment == ".." {
+				return errors.New("dot segment in path")
+			}
+		}
+		if !strings.HasPrefix(u.EscapedPath(), c.pathPrefix) {
+			return errors.New("path prefix mismatch")
+		}
+	}
+	return nil
+}
+
+func (c *urlConfig) checkPublicHost(host string) error {
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return errors.New("private host")
+	}
+	if addr, err := netip.ParseAddr(host); err == nil {
+		// the zones are only meaningful for the link-local addresses
+		if addr.Zone() != "" || isPrivateAddr(addr) {
+			return errors.New("private host")
+		}
+		return nil
+	}
+	// hosts like 2130706433 or 0x7f.1 are resolved as IPv4 addresses by some clients
+	last := host[strings.LastIndex(host, ".")+1:]
+	if hex, ok := strings.CutPrefix(last, "0x"); ok && strings.Trim(hex, "0123456789abcdef") == "" {
+		return errors.New("numeric host")
+	}
+	if strings.Trim(last, "0123456789") == "" {
+		return errors.New("numeric host")
+	}
+	if c.resolver != nil {
+		addrs, err := c.resolver.LookupIPAddr(context.Background(), host)
+		if err != nil || len(addrs) == 0 {
+			return errors.New("unresolvable host")
+		}
+		for _, addr := range addrs {
+			if ip, ok := netip.AddrFromSlice(addr.IP); !ok || isPrivateAddr(ip) {
+				return errors.New("private host")
+			}
+		}
+	}
+	return nil
+}
+
+// The special-purpose ranges that aren't covered by the methods of netip.Addr: "this network"
+// (0.0.0.0/8), the shared address space of the carrier-grade NATs (100.64.0.0/10) and the local-use
+// NAT64 prefix (64:ff9b:1::/48).
+var reservedPrefixes = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),
+	netip.MustParsePrefix("100.64.0.0/10"),
+	netip.MustParsePrefix("64:ff9b:1::/48"),
+}
+
+// The prefixes of the IPv6 addresses that embed IPv4 addresses: the well-known NAT64 prefix (the
+// IPv4 address is the last 4 bytes) and 6to4 (the IPv4 address follows the prefix).
+var (
+	nat64Prefix = netip.MustParsePrefix("64:ff9b::/96")
+	sixToFour   = netip.MustParsePrefix("2002::/16")
+)
+
+// Reports if the address isn't a public unicast address. The IPv4-mapped, NAT64 and 6to4 addresses
+// are checked by their IPv4 addresses.
+func isPrivateAddr(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	if b := addr.As16(); nat64Prefix.Contains(addr) {
+		addr = netip.AddrFrom4([4]byte{b[12], b[13], b[14], b[15]})
+	} else if sixToFour.Contains(addr) {
+		addr = netip.AddrFrom4([4]byte{b[2], b[3], b[4], b[5]})
+	}
+	if addr.IsLoopback() || addr.IsPrivate() || addr.IsLinkLocalUnicast() || addr.IsLinkLocalMulticast() ||
+		addr.IsInterfaceLocalMulticast() || addr.IsMulticast() || addr.IsUnspecified() {
+		return true
+	}
+	for _, prefix := range reservedPrefixes {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// Reports if the host matches one of the host patterns (see URLAllowHosts).
+func matchAnyHost(patterns []string, host string) bool {
+	for _, pattern := range patterns {
+		pattern, err := domainToASCII(pattern)
+		if err != nil {
+			continue
+		}
+		if suffix, ok := strings.CutPrefix(pattern, "*."); ok {
+			if strings.HasSuffix(host, "."+suffix) {
+				return true
+			}
+		} else if host == pattern {
+			return true
+		}
+	}
+	return false
+}
+
+func containsFold(list []string, s string) bool {
+	for _, e := range list {
+		if strings.EqualFold(e, s) {
+			return true
+		}
+	}
+	return false
+}
+
+func containsInt(list []int, n int) bool {
+	for _, e := range list {
+		if e == n {
+			return true
+		}
+	}
+	return false
+}
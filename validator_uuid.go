@@ -0,0 +1,181 @@
+// UUID (RFC 9562) and ULID validators that parse the identifiers and inspect their versions and timestamps,
+// unlike the UUID* regexps in validator_builtin.go.
+package funcvalid
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"math/big"
+	"strings"
+	"time"
+)
+
+// ParsedUUID is the 16 bytes of a UUID.
+type ParsedUUID [16]byte
+
+// Parses a UUID in its canonical form ("f81d4fae-7dec-11d0-a765-00a0c91e6bf6"), in braces
+// ("{f81d4fae-7dec-11d0-a765-00a0c91e6bf6}") or as an URN ("urn:uuid:f81d4fae-7dec-11d0-a765-00a0c91e6bf6").
+// The hex digits are case-insensitive.
+func ParseUUID(s string) (ParsedUUID, error) {
+	var u ParsedUUID
+	if len(s) == 38 && s[0] == '{' && s[37] == '}' {
+		s = s[1:37]
+	} else if len(s) == 45 && strings.EqualFold(s[:9], "urn:uuid:") {
+		s = s[9:]
+	}
+	if len(s) != 36 || s[8] != '-' || s[13] != '-' || s[18] != '-' || s[23] != '-' {
+		return u, ruleError("UUID", "description", "invalid format")
+	}
+	if _, err := hex.Decode(u[:], []byte(s[:8]+s[9:13]+s[14:18]+s[19:23]+s[24:])); err != nil {
+		return u, ruleError("UUID", "description", "invalid format")
+	}
+	return u, nil
+}
+
+// Returns the version of the UUID (the value of the version field, 0-15).
+func (u ParsedUUID) Version() int {
+	return int(u[6] >> 4)
+}
+
+// Reports if the variant of the UUID is the one that RFC 9562 (and RFC 4122) specifies.
+func (u ParsedUUID) IsRFC9562Variant() bool {
+	return u[8]&0xc0 == 0x80
+}
+
+// The number of 100ns intervals between the UUID epoch (1582-10-15) and the unix epoch.
+const uuidEpochOffset = 122192928000000000
+
+// Returns the timestamp of the time based UUIDs (version 1, 6 and 7).
+func (u ParsedUUID) Time() (time.Time, bool) {
+	if !u.IsRFC9562Variant() {
+		return time.Time{}, false
+	}
+	switch u.Version() {
+	case 1:
+		ts := uint64(binary.BigEndian.Uint16(u[6:8])&0x0fff)<<48 |
+			uint64(binary.BigEndian.Uint16(u[4:6]))<<32 | uint64(binary.BigEndian.Uint32(u[0:4]))
+		return uuidGregorianTime(ts), true
+	case 6:
+		ts := uint64(binary.BigEndian.Uint32(u[0:4]))<<28 |
+			uint64(binary.BigEndian.Uint16(u[4:6]))<<12 | uint64(binary.BigEndian.Uint16(u[6:8])&0x0fff)
+		return uuidGregorianTime(ts), true
+	case 7:
+		return time.UnixMilli(int64(uint48(u[0:6]))), true
+	}
+	return time.Time{}, false
+}
+
+func uuidGregorianTime(ts uint64) time.Time {
+	unix100ns := int64(ts) - uuidEpochOffset
+	return time.Unix(unix100ns/1e7, (unix100ns%1e7)*100)
+}
+
+func uint48(b []byte) uint64 {
+	return uint64(b[0])<<40 | uint64(b[1])<<32 | uint64(b[2])<<24 | uint64(b[3])<<16 | uint64(b[4])<<8 | uint64(b[5])
+}
+
+// Factory function with a number of version parameters that returns a validator, that validates if
+// the input is a UUID (see ParseUUID) of the RFC 9562 variant with one of the versions.
+func UUIDVersion(versions ...int) Validator[string] {
+	return withRule(newRule("UUIDVersion", "versions", versions), func(inp string) error {
+		if u, err := ParseUUID(inp); err == nil && u.IsRFC9562Variant() && containsInt(versions, u.Version()) {
+			return nil
+		}
+		return ruleError("UUIDVersion", "versions", versions)
+	})
+}
+
+// UUIDVariantRFC9562 is the validation function for validating if the input is a UUID (see ParseUUID)
+// of the RFC 9562 variant.
+func UUIDVariantRFC9562(input string) error {
+	if u, err := ParseUUID(input); err == nil && u.IsRFC9562Variant() {
+		return nil
+	}
+	return ruleError("UUIDVariantRFC9562")
+}
+
+// Factory function with a time parameter that returns a validator, that validates if the input is a
+// version 7 UUID with a timestamp after the parameter.
+func UUIDv7After(t time.Time) Validator[string] {
+	return withRule(newRule("UUIDv7After", "time", t), func(inp string) error {
+		if u, err := ParseUUID(inp); err == nil && u.Version() == 7 {
+			if ts, ok := u.Time(); ok && ts.After(t) {
+				return nil
+			}
+		}
+		return ruleError("UUIDv7After", "time", t)
+	})
+}
+
+// Factory function with a time parameter that returns a validator, that validates if the input is a
+// version 7 UUID with a timestamp before the parameter.
+func UUIDv7Before(t time.Time) Validator[string] {
+	return withRule(newRule("UUIDv7Before", "time", t), func(inp string) error {
+		if u, err := ParseUUID(inp); err == nil && u.Version() == 7 {
+			if ts, ok := u.Time(); ok && ts.Before(t) {
+				return nil
+			}
+		}
+		return ruleError("UUIDv7Before", "time", t)
+	})
+}
+
+// ParsedULID is the 16 bytes of a ULID.
+type ParsedULID [16]byte
+
+const crockfordAlphabet = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+
+// Parses a ULID (26 characters of Crockford's base32, case-insensitive). The first character
+// can't be greater than 7, as the ULID would overflow 128 bits.
+func ParseULID(s string) (ParsedULID, error) {
+	var u ParsedULID
+	if len(s) != 26 || s[0] > '7' {
+		return u, ruleError("ULID", "description", "invalid format")
+	}
+	n := new(big.Int)
+	for _, r := range strings.ToUpper(s) {
+		d := strings.IndexRune(crockfordAlphabet, r)
+		if d < 0 {
+			return u, ruleError("ULID", "description", "invalid format")
+		}
+		n.Lsh(n, 5)
+		n.Or(n, big.NewInt(int64(d)))
+	}
+	n.FillBytes(u[:])
+	return u, nil
+}
+
+// Returns the timestamp of the ULID.
+func (u ParsedULID) Time() time.Time {
+	return time.UnixMilli(int64(uint48(u[0:6])))
+}
+
+// ULID is the validation function for validating if the input is a ULID (see ParseULID).
+func ULID(input string) error {
+	if _, err := ParseULID(input); err == nil {
+		return nil
+	}
+	return ruleError("ULID")
+}
+
+// Factory function with a time parameter that returns a validator, that validates if the input is a
+// ULID with a timestamp after the parameter.
+func ULIDAfter(t time.Time) Validator[string] {
+	return withRule(newRule("ULIDAfter", "time", t), func(inp string) error {
+		if u, err := ParseULID(inp); err == nil && u.Time().After(t) {
+			return nil
+		}
+		return ruleError("ULIDAfter", "time", t)
+	})
+}
+
+// Factory function with a time parameter that returns a validator, that validates if the input is a
+// ULID with a timestamp before the parameter.
+func ULIDBefore(t time.Time) Validator[string] {
+	return withRule(newRule("ULIDBefore", "time", t), func(inp string) error {
+		if u, err := ParseULID(inp); err == nil && u.Time().Before(t) {
+			return nil
+		}
+		return ruleError("ULIDBefore", "time", t)
+	})
+}
@@ -0,0 +1,158 @@
+// Binder of query strings and form values (url.Values) to typed structs: every parameter is parsed by
+// its parser (e.g. Int, Bool, Time or CSV) and validated by its validator, e.g.:
+//
+//	type ListQuery struct {
+//		Page  int
+//		Tags  []string
+//		Since time.Time
+//	}
+//
+//	bindList := fv.BindValues(
+//		fv.Value("page", fv.Int, func(q *ListQuery) *int { return &q.Page }, fv.Gt(0)),
+//		fv.OptionalValue("tags", fv.CSV(fv.String), func(q *ListQuery) *[]string { return &q.Tags }, fv.Each(fv.Lowercase)),
+//		fv.OptionalValue("since", fv.Time(time.DateOnly), func(q *ListQuery) *time.Time { return &q.Since }, nil))
+//
+//	query, err := bindList(r.URL.Query())
+package funcvalid
+
+import (
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Parser is a function that parses a string value to a T, or returns an error if it's invalid.
+type Parser[T any] func(s string) (T, error)
+
+// String is the parser of the string values (that are always valid).
+func String(s string) (string, error) {
+	return s, nil
+}
+
+// Int is the parser of the decimal integer values.
+func Int(s string) (int, error) {
+	n, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, ruleError("Int")
+	}
+	return n, nil
+}
+
+// Float is the parser of the decimal floating-point values.
+func Float(s string) (float64, error) {
+	n, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return 0, ruleError("Float")
+	}
+	return n, nil
+}
+
+// Bool is the parser of the boolean values (1, t, T, TRUE, true, True, 0, f, F, FALSE, false or False).
+func Bool(s string) (bool, error) {
+	b, err := strconv.ParseBool(s)
+	if err != nil {
+		return false, ruleError("Bool")
+	}
+	return b, nil
+}
+
+// Returns the parser of the time values in the layout (see time.Parse).
+func Time(layout string) Parser[time.Time] {
+	return func(s string) (time.Time, error) {
+		t, err := time.Parse(layout, s)
+		if err != nil {
+			return time.Time{}, ruleError("Time", "layout", layout)
+		}
+		return t, nil
+	}
+}
+
+// Returns the parser of the comma-separated lists of values parsed by the parser. The error carries
+// the index of the first invalid element, and its error.
+func CSV[T any](parse Parser[T]) Parser[[]T] {
+	return func(s string) ([]T, error) {
+		elems := strings.Split(s, ",")
+		list := make([]T, len(elems))
+		for i, elem := range elems {
+			var err error
+			if list[i], err = parse(strings.TrimSpace(elem)); err != nil {
+				return nil, &RuleError{Code: "CSV", Params: map[string]any{IndexParam: i}, Errs: []error{err}}
+			}
+		}
+		return list, nil
+	}
+}
+
+// ValueField is the binder of a parameter of the url.Values to a field of the S struct type (see
+// Value and OptionalValue).
+type ValueField[S any] struct {
+	name     string
+	required bool
+	bind     func(s *S, value string) error
+}
+
+// Returns the binder of a required parameter with the name, that parses its value with the parser,
+// validates it with the validator (if it's not nil), and stores it in the field returned by the field
+// function.
+func Value[S any, T any](name string, parse Parser[T], field func(s *S) *T, validator Validator[T]) ValueField[S] {
+	return valueField(name, parse, field, validator, true)
+}
+
+// Returns the binder of an optional parameter with the name, that parses its value with the parser,
+// validates it with the validator (if it's not nil), and stores it in the field returned by the field
+// function. The field is left unchanged if the parameter is missing.
+func OptionalValue[S any, T any](name string, parse Parser[T], field func(s *S) *T, validator Validator[T]) ValueField[S] {
+	return valueField(name, parse, field, validator, false)
+}
+
+func valueField[S any, T any](name string, parse Parser[T], field func(s *S) *T, validator Validator[T], required bool) ValueField[S] {
+	return ValueField[S]{
+		name:     name,
+		required: required,
+		bind: func(s *S, value string) error {
+			v, err := parse(value)
+			if err != nil {
+				return err
+			}
+			if validator != nil {
+				if err := validator(v); err != nil {
+					return err
+				}
+			}
+			*field(s) = v
+			return nil
+		},
+	}
+}
+
+// Factory function that takes variable number of parameter binders, and returns a function that binds
+// the url.Values to a new S struct. The empty values are treated as missing, and the parameters must
+// not be repeated. The error lists the FieldErrors of all the missing, repeated or invalid parameters
+// (keyed by their names), e.g. "error: Values (page: error: Required, tags: error: Duplicate)".
+func BindValues[S any](fields ...ValueField[S]) func(values url.Values) (S, error) {
+	return func(values url.Values) (S, error) {
+		var s S
+		var errs []error
+		for _, f := range fields {
+			var err error
+			switch vs := values[f.name]; {
+			case len(vs) > 1:
+				err = ruleError("Duplicate")
+			case len(vs) == 0 || vs[0] == "":
+				if f.required {
+					err = ruleError("Required")
+				}
+			default:
+				err = f.bind(&s, vs[0])
+			}
+			if err != nil {
+				errs = append(errs, &FieldError{Field: f.name, Err: err})
+			}
+		}
+		if len(errs) > 0 {
+			return s, &RuleError{Code: "Values", Errs: errs}
+		}
+		return s, nil
+	}
+}